@@ -3,14 +3,28 @@ package app
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 )
 
+// CommitHook is called after every commit with the new height and
+// all changes made to the state in that block.
+//
+// Returning an error is a critical condition and stops the application.
+// The block is committed already, so the hook must detect that it missed
+// it when restarted, see changelog.NewWriter.
+type CommitHook func(height int64, changes store.Changeset) error
+
 // CommitStore handles loading from a KVCommitStore, maintaining different
 // CacheWraps for Deliver and Check, and returning useful state info.
 type CommitStore struct {
 	committed weave.CommitKVStore
 	deliver   weave.KVCacheWrap
 	check     weave.KVCacheWrap
+
+	// hook is optional, when set all writes to deliver
+	// go through recorder to build the block changeset
+	hook     CommitHook
+	recorder weave.CacheableKVStore
 }
 
 // NewCommitStore loads the CommitKVStore from disk or panics. It sets up the
@@ -33,12 +47,39 @@ func (cs *CommitStore) CommitInfo() (version int64, hash []byte) {
 	return id.Version, id.Hash
 }
 
+// WithCommitHook sets a hook that is called with all changes of a block
+// once it is committed. Only one hook can be set, a nil hook disables it.
+//
+// Set it before processing any block, as only changes made from now
+// on are recorded.
+func (cs *CommitStore) WithCommitHook(hook CommitHook) {
+	cs.hook = hook
+	cs.resetRecorder()
+}
+
+// resetRecorder starts recording the changes of a new block
+// on top of the current deliver cache, if a hook is set
+func (cs *CommitStore) resetRecorder() {
+	cs.recorder = nil
+	if cs.hook != nil {
+		cs.recorder = store.NewRecordingStore(cs.deliver).(weave.CacheableKVStore)
+	}
+}
+
 // Commit will flush deliver to the underlying store and commit it
 // to disk. It then regenerates new deliver/check caches
 //
+// If a commit hook is set, it is called with the block changeset
+// after the commit. It panics if the hook returns an error.
+//
 // TODO: this should probably be protected by a mutex....
 // need to think what concurrency we expect
 func (cs *CommitStore) Commit() weave.CommitID {
+	var changes store.Changeset
+	if cs.recorder != nil {
+		changes = cs.recorder.(store.Recorder).Changeset()
+	}
+
 	// flush deliver to store and discard check
 	cs.deliver.Write()
 	cs.check.Discard()
@@ -49,6 +90,13 @@ func (cs *CommitStore) Commit() weave.CommitID {
 	// set up new caches
 	cs.deliver = cs.committed.CacheWrap()
 	cs.check = cs.committed.CacheWrap()
+	cs.resetRecorder()
+
+	if cs.hook != nil {
+		if err := cs.hook(res.Version, changes); err != nil {
+			panic(err)
+		}
+	}
 	return res
 }
 
@@ -61,6 +109,9 @@ func (cs *CommitStore) CheckStore() weave.CacheableKVStore {
 // DeliverStore returns a store implementation that must be used during the
 // delivery phase.
 func (cs *CommitStore) DeliverStore() weave.CacheableKVStore {
+	if cs.recorder != nil {
+		return cs.recorder
+	}
 	return cs.deliver
 }
 
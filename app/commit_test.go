@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/store/iavl"
+)
+
+func TestCommitHook(t *testing.T) {
+	cs := NewCommitStore(iavl.MockCommitStore())
+
+	var heights []int64
+	var blocks []store.Changeset
+	cs.WithCommitHook(func(height int64, changes store.Changeset) error {
+		heights = append(heights, height)
+		blocks = append(blocks, changes)
+		return nil
+	})
+
+	// first block creates a key, and writes one through a savepoint
+	cs.DeliverStore().Set([]byte("foo:a"), []byte("1"))
+	cache := cs.DeliverStore().CacheWrap()
+	cache.Set([]byte("foo:b"), []byte("2"))
+	cache.Write()
+	// discarded and check writes are never recorded
+	cache = cs.DeliverStore().CacheWrap()
+	cache.Set([]byte("foo:c"), []byte("3"))
+	cache.Discard()
+	cs.CheckStore().Set([]byte("foo:d"), []byte("4"))
+	cs.Commit()
+
+	// second block updates and deletes
+	cs.DeliverStore().Set([]byte("foo:a"), []byte("10"))
+	cs.DeliverStore().Delete([]byte("foo:b"))
+	cs.Commit()
+
+	require.Equal(t, []int64{1, 2}, heights)
+	assert.Equal(t, store.Changeset{
+		{Key: []byte("foo:a"), Value: []byte("1")},
+		{Key: []byte("foo:b"), Value: []byte("2")},
+	}, blocks[0])
+	assert.Equal(t, store.Changeset{
+		{Key: []byte("foo:a"), Prev: []byte("1"), Value: []byte("10")},
+		{Key: []byte("foo:b"), Prev: []byte("2")},
+	}, blocks[1])
+}
@@ -1,7 +1,9 @@
 package app
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/iov-one/weave"
 )
@@ -26,6 +28,26 @@ func ResultsFromValues(models []weave.Model) *ResultSet {
 	return &ResultSet{res}
 }
 
+// jsonResult is a single result of a query with the JSON modifier
+type jsonResult struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ResultsToJSON returns a JSON array of all models, given
+// their values are already JSON encoded (see weave.JSONQueryMod).
+// Keys are rendered as upper case hex.
+func ResultsToJSON(models []weave.Model) ([]byte, error) {
+	res := make([]jsonResult, len(models))
+	for i, m := range models {
+		res[i] = jsonResult{
+			Key:   fmt.Sprintf("%X", m.Key),
+			Value: m.Value,
+		}
+	}
+	return json.Marshal(res)
+}
+
 // JoinResults inverts ResultsFromKeys and ResultsFromValues
 // and makes then a consistent whole again
 func JoinResults(keys, values *ResultSet) ([]weave.Model, error) {
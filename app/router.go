@@ -15,8 +15,8 @@ const DefaultRouterSize = 10
 var isPath = regexp.MustCompile(`^[a-zA-Z0-9_/]+$`).MatchString
 
 // CodeNoSuchPath is an ABCI Response Codes
-// Base SDK reserves 0 ~ 99. App uses 10 ~ 19
-const CodeNoSuchPath uint32 = 10
+// An unknown path is an unknown request (see errors.UnknownRequestErr)
+const CodeNoSuchPath = errors.CodeUnknownRequest
 
 var errNoSuchPath = fmt.Errorf("Path not registered")
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -75,7 +76,7 @@ func NewStoreApp(name string, store weave.CommitKVStore,
 	s = s.WithLogger(log.NewNopLogger())
 
 	// load the chainID from the db
-	s.chainID = loadChainID(s.DeliverStore())
+	s.chainID = loadChainID(s.store.DeliverStore())
 	if s.chainID != "" {
 		s.baseContext = weave.WithChainID(s.baseContext, s.chainID)
 	}
@@ -156,14 +157,28 @@ func (s *StoreApp) BlockContext() weave.Context {
 	return s.blockContext
 }
 
-// DeliverStore returns the current DeliverTx cache for methods
+// WithCommitHook sets a hook called with the changeset of every
+// committed block, see CommitStore.WithCommitHook
+func (s *StoreApp) WithCommitHook(hook CommitHook) *StoreApp {
+	s.store.WithCommitHook(hook)
+	return s
+}
+
+// DeliverStore returns the current DeliverTx cache for methods.
+// It knows the height of the current block, see store.WithHeight
 func (s *StoreApp) DeliverStore() weave.CacheableKVStore {
-	return s.store.deliver
+	return s.withHeight(s.store.DeliverStore())
 }
 
-// CheckStore returns the current CheckTx cache for methods
+// CheckStore returns the current CheckTx cache for methods.
+// It knows the height of the current block, see store.WithHeight
 func (s *StoreApp) CheckStore() weave.CacheableKVStore {
-	return s.store.check
+	return s.withHeight(s.store.CheckStore())
+}
+
+func (s *StoreApp) withHeight(db weave.CacheableKVStore) weave.CacheableKVStore {
+	height, _ := weave.GetHeight(s.blockContext)
+	return store.WithHeight(db, height)
 }
 
 //----------------------- ABCI ---------------------
@@ -204,6 +219,10 @@ Path may be "/", "/<bucket>", or "/<bucket>/<index>"
 It may be followed by "?prefix" to make a prefix query.
 Soon we will support "?range" for powerful range queries
 
+Adding "json" to the modifier (eg. "?json" or "?prefix,json")
+returns Value as a JSON array of {"key", "value"} objects, with
+the values decoded by the bucket, instead of ResultSets.
+
 Key and Value in Results are always serialized ResultSet
 objects, able to support 0 to N values. They must be the
 same size. This makes things a little more difficult for
@@ -233,14 +252,26 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 	height, _ := s.store.CommitInfo()
 	resQuery.Height = height
 	// TODO: better version handling!
-	db := s.store.committed.CacheWrap()
+	// queries must never modify the state, so guard against
+	// buggy handlers writing to the committed store
+	db := store.ReadOnly(s.store.committed.CacheWrap())
 
 	// make the query
-	models, err := qh.Query(db, mod, reqQuery.Data)
+	models, err := runQuery(qh, db, mod, reqQuery.Data)
 	if err != nil {
 		return queryError(err)
 	}
 
+	// values are already JSON encoded by the handler,
+	// return them as a single JSON document
+	if _, asJSON := weave.ParseQueryMod(mod); asJSON {
+		resQuery.Value, err = ResultsToJSON(models)
+		if err != nil {
+			return queryError(err)
+		}
+		return resQuery
+	}
+
 	// set the info as ResultSets....
 	resQuery.Key, err = ResultsFromKeys(models).Marshal()
 	if err != nil {
@@ -264,6 +295,15 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 	return resQuery
 }
 
+// runQuery calls the handler, turning any panic (eg. a write
+// to the read-only store) into an error
+func runQuery(qh weave.QueryHandler, db weave.ReadOnlyKVStore,
+	mod string, data []byte) (models []weave.Model, err error) {
+
+	defer errors.Recover(&err)
+	return qh.Query(db, mod, data)
+}
+
 // splitPath splits out the real path along with the query
 // modifier (everything after the ?)
 func splitPath(path string) (string, string) {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/x"
@@ -37,7 +38,7 @@ func Authenticator() x.Authenticator {
 func Chain(authFn x.Authenticator) app.Decorators {
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
-	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
+	var ctrl cash.Controller = currency.NewController(cash.NewController(cash.NewBucket()))
 
 	return app.ChainDecorators(
 		utils.NewLogging(),
@@ -63,7 +64,7 @@ func Chain(authFn x.Authenticator) app.Decorators {
 func Router(authFn x.Authenticator, issuer weave.Address) app.Router {
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
-	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
+	var ctrl cash.Controller = currency.NewController(cash.NewController(cash.NewBucket()))
 
 	r := app.NewRouter()
 	cash.RegisterRoutes(r, authFn, ctrl)
@@ -71,11 +72,13 @@ func Router(authFn x.Authenticator, issuer weave.Address) app.Router {
 	escrow.RegisterRoutes(r, authFn, ctrl)
 	multisig.RegisterRoutes(r, authFn)
 	validators.RegisterRoutes(r, authFn, validators.NewController())
+	gconf.RegisterRoutes(r, authFn)
 	return r
 }
 
 // QueryRouter returns a default query router,
-// allowing access to "/wallets", "/validators", "/auth", "/", and "/escrows"
+// allowing access to "/wallets", "/validators", "/auth", "/", "/escrows"
+// and "/gconf"
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	r.RegisterAll(
@@ -84,6 +87,7 @@ func QueryRouter() weave.QueryRouter {
 		currency.RegisterQuery,
 		sigs.RegisterQuery,
 		orm.RegisterQuery,
+		gconf.RegisterQuery,
 		multisig.RegisterQuery,
 		validators.RegisterQuery,
 	)
@@ -109,10 +113,19 @@ func Application(name string, h weave.Handler,
 		return app.BaseApp{}, err
 	}
 	store := app.NewStoreApp(name, kv, QueryRouter(), ctx)
-	base := app.NewBaseApp(store, tx, h, nil, debug)
+	base := app.NewBaseApp(store, tx, h, Migrations(), debug)
 	return base, nil
 }
 
+// Migrations returns the state migrations of all extensions, they are
+// applied at the beginning of the first block processed by this version.
+func Migrations() orm.Migrations {
+	var ms orm.Migrations
+	ms = append(ms, escrow.Migrations()...)
+	ms = append(ms, currency.Migrations()...)
+	return ms
+}
+
 // CommitKVStore returns an initialized KVStore that persists
 // the data to the named path.
 func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
@@ -13,6 +13,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
@@ -85,6 +86,39 @@ func TestSendTx(t *testing.T) {
 	assert.NotEqual(t, 0, dres.Code)
 }
 
+func TestUpdateConfiguration(t *testing.T) {
+	chainID := "test-net-22"
+	owner := &account{pk: crypto.GenPrivKeyEd25519()}
+	other := &account{pk: crypto.GenPrivKeyEd25519()}
+	myApp := newTestApp(t, chainID, []*account{owner, other})
+
+	fee := x.NewCoin(0, 1000, "ETH")
+	raw, err := json.Marshal(fee)
+	require.NoError(t, err)
+	update := func() *Tx {
+		return &Tx{
+			Sum: &Tx_UpdateConfigurationMsg{&gconf.UpdateConfigurationMsg{
+				Name:  cash.GconfMinimalFee,
+				Value: raw,
+			}},
+		}
+	}
+
+	// only the owner of the cash configuration may update it
+	dres := signAndCommit(t, true, myApp, update(), []*account{other}, chainID, 2)
+	assert.NotEqual(t, uint32(0), dres.Code)
+	signAndCommit(t, false, myApp, update(), []*account{owner}, chainID, 3)
+
+	res := myApp.Query(abci.RequestQuery{Path: "/gconf", Data: []byte(cash.GconfMinimalFee)})
+	require.Equal(t, uint32(0), res.Code, "%#v", res)
+	var set app.ResultSet
+	require.NoError(t, set.Unmarshal(res.Value))
+	require.Len(t, set.Results, 1)
+	var got x.Coin
+	require.NoError(t, json.Unmarshal(set.Results[0], &got))
+	assert.Equal(t, fee, got)
+}
+
 func toHex(s string) string {
 	h := hex.EncodeToString([]byte(s))
 	return strings.ToUpper(h)
@@ -223,16 +257,31 @@ func withWalletAppState(t require.TestingT, accounts []*account) string {
 		Address weave.Address `json:"address"`
 		Coins   x.Coins       `json:"coins"`
 	}
+	type token struct {
+		Ticker  string `json:"ticker"`
+		Name    string `json:"name"`
+		SigFigs int32  `json:"sig_figs"`
+	}
 	state := struct {
-		Cash  []wallet               `json:"cash"`
-		Gconf map[string]interface{} `json:"gconf"`
+		Cash       []wallet               `json:"cash"`
+		Currencies []token                `json:"currencies"`
+		Gconf      map[string]interface{} `json:"gconf"`
+		// the first account may update the cash configuration
+		GconfOwners map[string]weave.Address `json:"gconf_owners"`
 	}{
+		Currencies: []token{
+			{Ticker: "ETH", Name: "Ether", SigFigs: 9},
+			{Ticker: "FRNK", Name: "Frank", SigFigs: 9},
+		},
 		Gconf: map[string]interface{}{
-			cash.GconfCollectorAddress: "fake-collector-address",
+			cash.GconfCollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
 			cash.GconfMinimalFee:       x.Coin{}, // no fee
 		},
 	}
 
+	if len(accounts) > 0 {
+		state.GconfOwners = map[string]weave.Address{"cash:": accounts[0].address()}
+	}
 	for _, acc := range accounts {
 		state.Cash = append(state.Cash, wallet{
 			Address: acc.address(),
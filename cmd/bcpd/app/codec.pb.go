@@ -1,11 +1,11 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: app/codec.proto
+// source: cmd/bcpd/app/codec.proto
 
 /*
 	Package app is a generated protocol buffer package.
 
 	It is generated from these files:
-		app/codec.proto
+		cmd/bcpd/app/codec.proto
 
 	It has these top-level messages:
 		Tx
@@ -17,6 +17,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import gconf "github.com/iov-one/weave/gconf"
 import cash "github.com/iov-one/weave/x/cash"
 import currency "github.com/iov-one/weave/x/currency"
 import escrow "github.com/iov-one/weave/x/escrow"
@@ -40,11 +41,11 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Tx contains the message.
 //
 // When extending Tx, follow the rules:
-// - range 1-50 is reserved for middlewares,
-// - range 51-inf is reserved for different message types,
-// - keep the same numbers for the same message types in both bcpd and bnsd
-//   applications. For example, FeeInfo field is used by both and indexed at
-//   first position. Skip unused fields (leave index unused).
+//   - range 1-50 is reserved for middlewares,
+//   - range 51-inf is reserved for different message types,
+//   - keep the same numbers for the same message types in both bcpd and bnsd
+//     applications. For example, FeeInfo field is used by both and indexed at
+//     first position. Skip unused fields (leave index unused).
 type Tx struct {
 	Fees       *cash.FeeInfo        `protobuf:"bytes,1,opt,name=fees" json:"fees,omitempty"`
 	Signatures []*sigs.StdSignature `protobuf:"bytes,2,rep,name=signatures" json:"signatures,omitempty"`
@@ -65,6 +66,7 @@ type Tx struct {
 	//	*Tx_SetValidatorsMsg
 	//	*Tx_NewTokenInfoMsg
 	//	*Tx_BatchMsg
+	//	*Tx_UpdateConfigurationMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -109,17 +111,21 @@ type Tx_NewTokenInfoMsg struct {
 type Tx_BatchMsg struct {
 	BatchMsg *BatchMsg `protobuf:"bytes,60,opt,name=batch_msg,json=batchMsg,oneof"`
 }
+type Tx_UpdateConfigurationMsg struct {
+	UpdateConfigurationMsg *gconf.UpdateConfigurationMsg `protobuf:"bytes,66,opt,name=update_configuration_msg,json=updateConfigurationMsg,oneof"`
+}
 
-func (*Tx_SendMsg) isTx_Sum()           {}
-func (*Tx_CreateEscrowMsg) isTx_Sum()   {}
-func (*Tx_ReleaseEscrowMsg) isTx_Sum()  {}
-func (*Tx_ReturnEscrowMsg) isTx_Sum()   {}
-func (*Tx_UpdateEscrowMsg) isTx_Sum()   {}
-func (*Tx_CreateContractMsg) isTx_Sum() {}
-func (*Tx_UpdateContractMsg) isTx_Sum() {}
-func (*Tx_SetValidatorsMsg) isTx_Sum()  {}
-func (*Tx_NewTokenInfoMsg) isTx_Sum()   {}
-func (*Tx_BatchMsg) isTx_Sum()          {}
+func (*Tx_SendMsg) isTx_Sum()                {}
+func (*Tx_CreateEscrowMsg) isTx_Sum()        {}
+func (*Tx_ReleaseEscrowMsg) isTx_Sum()       {}
+func (*Tx_ReturnEscrowMsg) isTx_Sum()        {}
+func (*Tx_UpdateEscrowMsg) isTx_Sum()        {}
+func (*Tx_CreateContractMsg) isTx_Sum()      {}
+func (*Tx_UpdateContractMsg) isTx_Sum()      {}
+func (*Tx_SetValidatorsMsg) isTx_Sum()       {}
+func (*Tx_NewTokenInfoMsg) isTx_Sum()        {}
+func (*Tx_BatchMsg) isTx_Sum()               {}
+func (*Tx_UpdateConfigurationMsg) isTx_Sum() {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -226,6 +232,13 @@ func (m *Tx) GetBatchMsg() *BatchMsg {
 	return nil
 }
 
+func (m *Tx) GetUpdateConfigurationMsg() *gconf.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_UpdateConfigurationMsg); ok {
+		return x.UpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -239,6 +252,7 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_SetValidatorsMsg)(nil),
 		(*Tx_NewTokenInfoMsg)(nil),
 		(*Tx_BatchMsg)(nil),
+		(*Tx_UpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -296,6 +310,11 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.BatchMsg); err != nil {
 			return err
 		}
+	case *Tx_UpdateConfigurationMsg:
+		_ = b.EncodeVarint(66<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -386,6 +405,14 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_BatchMsg{msg}
 		return true, err
+	case 66: // sum.update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gconf.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_UpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -445,6 +472,11 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(60<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_UpdateConfigurationMsg:
+		s := proto.Size(x.UpdateConfigurationMsg)
+		n += proto.SizeVarint(66<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1035,6 +1067,22 @@ func (m *Tx_BatchMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Tx_UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateConfigurationMsg.Size()))
+		n13, err := m.UpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
 func (m *BatchMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1081,11 +1129,11 @@ func (m *BatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn13, err := m.Sum.MarshalTo(dAtA[i:])
+		nn14, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn13
+		i += nn14
 	}
 	return i, nil
 }
@@ -1096,11 +1144,11 @@ func (m *BatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n14, err := m.SendMsg.MarshalTo(dAtA[i:])
+		n15, err := m.SendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	return i, nil
 }
@@ -1110,11 +1158,11 @@ func (m *BatchMsg_Union_CreateEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CreateEscrowMsg.Size()))
-		n15, err := m.CreateEscrowMsg.MarshalTo(dAtA[i:])
+		n16, err := m.CreateEscrowMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	return i, nil
 }
@@ -1124,11 +1172,11 @@ func (m *BatchMsg_Union_ReleaseEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ReleaseEscrowMsg.Size()))
-		n16, err := m.ReleaseEscrowMsg.MarshalTo(dAtA[i:])
+		n17, err := m.ReleaseEscrowMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	return i, nil
 }
@@ -1138,11 +1186,11 @@ func (m *BatchMsg_Union_ReturnEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ReturnEscrowMsg.Size()))
-		n17, err := m.ReturnEscrowMsg.MarshalTo(dAtA[i:])
+		n18, err := m.ReturnEscrowMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	return i, nil
 }
@@ -1152,11 +1200,11 @@ func (m *BatchMsg_Union_UpdateEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowMsg.Size()))
-		n18, err := m.UpdateEscrowMsg.MarshalTo(dAtA[i:])
+		n19, err := m.UpdateEscrowMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	return i, nil
 }
@@ -1166,11 +1214,11 @@ func (m *BatchMsg_Union_CreateContractMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CreateContractMsg.Size()))
-		n19, err := m.CreateContractMsg.MarshalTo(dAtA[i:])
+		n20, err := m.CreateContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	return i, nil
 }
@@ -1180,11 +1228,11 @@ func (m *BatchMsg_Union_UpdateContractMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateContractMsg.Size()))
-		n20, err := m.UpdateContractMsg.MarshalTo(dAtA[i:])
+		n21, err := m.UpdateContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	return i, nil
 }
@@ -1194,11 +1242,11 @@ func (m *BatchMsg_Union_SetValidatorsMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SetValidatorsMsg.Size()))
-		n21, err := m.SetValidatorsMsg.MarshalTo(dAtA[i:])
+		n22, err := m.SetValidatorsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	return i, nil
 }
@@ -1208,11 +1256,11 @@ func (m *BatchMsg_Union_NewTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.NewTokenInfoMsg.Size()))
-		n22, err := m.NewTokenInfoMsg.MarshalTo(dAtA[i:])
+		n23, err := m.NewTokenInfoMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	return i, nil
 }
@@ -1344,6 +1392,15 @@ func (m *Tx_BatchMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Tx_UpdateConfigurationMsg) Size() (n int) {
+	var l int
+	_ = l
+	if m.UpdateConfigurationMsg != nil {
+		l = m.UpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *BatchMsg) Size() (n int) {
 	var l int
 	_ = l
@@ -1933,6 +1990,38 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Tx_BatchMsg{v}
 			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gconf.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UpdateConfigurationMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2478,48 +2567,51 @@ var (
 	ErrIntOverflowCodec   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("app/codec.proto", fileDescriptorCodec) }
+func init() { proto.RegisterFile("cmd/bcpd/app/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0xdb, 0x4c,
-	0x10, 0xc6, 0x31, 0x49, 0x20, 0x2c, 0xef, 0x2b, 0x60, 0x39, 0x34, 0x4d, 0xab, 0x34, 0xe5, 0x84,
-	0xda, 0xb2, 0x56, 0xa1, 0xf4, 0xff, 0x29, 0x88, 0x8a, 0x4a, 0x50, 0x55, 0x0e, 0xf4, 0x1a, 0x6d,
-	0xec, 0x89, 0xb1, 0x8a, 0x77, 0xad, 0xdd, 0x35, 0xa1, 0xdf, 0xa2, 0xa7, 0x7e, 0x26, 0x8e, 0x9c,
-	0x7b, 0xa8, 0x2a, 0xfa, 0x45, 0xaa, 0x5d, 0xdb, 0xe0, 0xf5, 0x21, 0x6a, 0x2b, 0xdf, 0x76, 0x66,
-	0x9e, 0xf9, 0x65, 0x3c, 0xbb, 0x8f, 0x82, 0x56, 0x68, 0x92, 0xb8, 0x3e, 0x0f, 0xc0, 0x27, 0x89,
-	0xe0, 0x8a, 0xe3, 0x06, 0x4d, 0x92, 0xee, 0x56, 0x18, 0xa9, 0xd3, 0x74, 0x4c, 0x7c, 0x1e, 0xbb,
-	0x21, 0x0f, 0xb9, 0x6b, 0x6a, 0xe3, 0x74, 0x62, 0x22, 0x13, 0x98, 0x53, 0xd6, 0xd3, 0x7d, 0x5c,
-	0x92, 0x47, 0xfc, 0x7c, 0x8b, 0x33, 0x70, 0xa7, 0x40, 0xcf, 0xc1, 0xbd, 0x70, 0x7d, 0x2a, 0x4f,
-	0xcb, 0x3f, 0xd0, 0x75, 0x67, 0x89, 0x53, 0x21, 0x80, 0xf9, 0x5f, 0xac, 0x86, 0xad, 0x19, 0x0d,
-	0x20, 0x7d, 0xc1, 0xa7, 0x7f, 0xcc, 0x8f, 0xd3, 0x33, 0x15, 0xc9, 0x28, 0xb4, 0x1a, 0x66, 0x4d,
-	0x2f, 0xa3, 0x50, 0x5a, 0xe2, 0xa7, 0x33, 0xc4, 0xe7, 0xf4, 0x2c, 0x0a, 0xa8, 0xe2, 0xc2, 0x6a,
-	0xd9, 0xb8, 0x5a, 0x40, 0xf3, 0xc7, 0x17, 0xf8, 0x21, 0x6a, 0x4e, 0x00, 0x64, 0xc7, 0xe9, 0x3b,
-	0x9b, 0xcb, 0xdb, 0xff, 0x13, 0xbd, 0x18, 0xf2, 0x0e, 0xe0, 0x3d, 0x9b, 0x70, 0xcf, 0x94, 0xf0,
-	0x36, 0x42, 0x32, 0x0a, 0x19, 0x55, 0xa9, 0x00, 0xd9, 0x99, 0xef, 0x37, 0x36, 0x97, 0xb7, 0x31,
-	0xd1, 0x33, 0x90, 0xa1, 0x0a, 0x86, 0x45, 0xc9, 0x2b, 0xa9, 0x70, 0x17, 0xb5, 0x13, 0x01, 0x51,
-	0x4c, 0x43, 0xe8, 0x34, 0xfa, 0xce, 0xe6, 0x7f, 0xde, 0x4d, 0xac, 0x6b, 0xc5, 0x17, 0x77, 0x9a,
-	0xfd, 0x86, 0xae, 0x15, 0x31, 0x7e, 0x84, 0xda, 0x12, 0x58, 0x30, 0x8a, 0x65, 0xd8, 0xd9, 0x29,
-	0x8f, 0x34, 0x04, 0x16, 0x1c, 0xc9, 0xf0, 0x60, 0xce, 0x5b, 0x94, 0xd9, 0x11, 0xef, 0xa3, 0x35,
-	0x5f, 0x00, 0x55, 0x30, 0xca, 0xf6, 0x6d, 0x9a, 0x9e, 0x99, 0xa6, 0x3b, 0x24, 0x4b, 0x91, 0x3d,
-	0x23, 0xd8, 0x37, 0x41, 0xd6, 0xbe, 0xe2, 0xdb, 0x29, 0x7c, 0x80, 0xb0, 0x80, 0x33, 0xa0, 0xd2,
-	0xe2, 0xec, 0x1a, 0x4e, 0xa7, 0xe0, 0x78, 0x99, 0xa2, 0x0c, 0x5a, 0x15, 0x95, 0x9c, 0x1e, 0x48,
-	0x80, 0x4a, 0x05, 0x2b, 0x83, 0x9e, 0xdb, 0x03, 0x79, 0x46, 0x60, 0x0d, 0x24, 0xec, 0x14, 0x3e,
-	0x44, 0x6b, 0x69, 0x12, 0x54, 0xbe, 0xeb, 0x85, 0xc1, 0xf4, 0x0a, 0xcc, 0x89, 0x11, 0x64, 0x3d,
-	0x1f, 0xa9, 0x50, 0x11, 0xc8, 0x9c, 0x96, 0x96, 0x2a, 0x9a, 0x76, 0x84, 0xd6, 0xf3, 0x2d, 0xf9,
-	0x9c, 0x29, 0x41, 0x7d, 0x65, 0x78, 0x2f, 0x0d, 0xef, 0x1e, 0x29, 0x36, 0x9f, 0x6f, 0x6a, 0x2f,
-	0xd7, 0x64, 0xb0, 0x7c, 0xbf, 0xa5, 0xa4, 0xc6, 0xe5, 0xc3, 0x59, 0xb8, 0x57, 0x55, 0x5c, 0x36,
-	0x60, 0x05, 0x97, 0x56, 0x93, 0xf8, 0x10, 0x61, 0x09, 0x6a, 0x74, 0xfb, 0x46, 0x0d, 0xed, 0xb5,
-	0xa1, 0xdd, 0x27, 0xb7, 0x69, 0x32, 0x04, 0xf5, 0xe9, 0x26, 0xca, 0x2f, 0x40, 0x56, 0x72, 0xfa,
-	0x2a, 0x19, 0x4c, 0x47, 0x8a, 0x7f, 0x06, 0x36, 0x8a, 0xd8, 0x84, 0x1b, 0xda, 0x1b, 0x43, 0xbb,
-	0x4b, 0x0a, 0x1b, 0x93, 0x0f, 0x30, 0x3d, 0xd6, 0x12, 0xfd, 0xc6, 0xf3, 0xad, 0x31, 0x3b, 0x85,
-	0x9f, 0xa0, 0xa5, 0x31, 0x55, 0xfe, 0xa9, 0x01, 0xbc, 0xcd, 0x1f, 0x22, 0x4d, 0x12, 0x32, 0xd0,
-	0xd9, 0xac, 0xa9, 0x3d, 0xce, 0xcf, 0x83, 0x16, 0x6a, 0xc8, 0x34, 0xde, 0xf8, 0xde, 0x42, 0xed,
-	0xa2, 0x8e, 0x77, 0x51, 0x3b, 0x06, 0x29, 0x69, 0x68, 0xcc, 0xa5, 0x3d, 0xb3, 0x6e, 0x01, 0xc8,
-	0x09, 0x8b, 0x38, 0x1b, 0x34, 0x2f, 0x7f, 0x3c, 0x98, 0xf3, 0x6e, 0xa4, 0xdd, 0x6f, 0x2d, 0xd4,
-	0x32, 0x15, 0xcb, 0x0a, 0xce, 0xbf, 0x58, 0xa1, 0x59, 0x93, 0x15, 0x5a, 0x75, 0x59, 0x61, 0xa1,
-	0x1e, 0x2b, 0x2c, 0xd6, 0x6c, 0x85, 0x76, 0xbd, 0x56, 0x58, 0xaa, 0xd5, 0x0a, 0xa8, 0x56, 0x2b,
-	0x2c, 0xff, 0xbd, 0x15, 0xf2, 0xc7, 0x3d, 0x58, 0xbd, 0xbc, 0xee, 0x39, 0x57, 0xd7, 0x3d, 0xe7,
-	0xe7, 0x75, 0xcf, 0xf9, 0xfa, 0xab, 0x37, 0x37, 0x5e, 0x30, 0x7f, 0x24, 0x3b, 0xbf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x7a, 0xd3, 0xf4, 0x65, 0xad, 0x07, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x31, 0x49, 0x20, 0x0c, 0xf7, 0xea, 0xc2, 0x20, 0xdd, 0xeb, 0x9b, 0xb6, 0x69, 0xca,
+	0x0a, 0xd1, 0x32, 0x56, 0xa1, 0xf4, 0xff, 0x2a, 0x88, 0x8a, 0x4a, 0x50, 0x55, 0x0e, 0x54, 0xea,
+	0x2a, 0x9a, 0x8c, 0x4f, 0x8c, 0x55, 0x3c, 0x63, 0xcd, 0x8c, 0x09, 0x7d, 0x8b, 0xae, 0xfa, 0x12,
+	0x7d, 0x11, 0x96, 0x5d, 0x77, 0x51, 0x55, 0xf4, 0x45, 0x2a, 0x8f, 0x6d, 0x62, 0x5b, 0x2d, 0xa2,
+	0x95, 0x77, 0x73, 0xce, 0xf9, 0xce, 0x2f, 0xc7, 0xc7, 0xf3, 0xc5, 0xc8, 0x66, 0xa1, 0xe7, 0x8c,
+	0x58, 0xe4, 0x39, 0x34, 0x8a, 0x1c, 0x26, 0x3c, 0x60, 0x24, 0x92, 0x42, 0x0b, 0xdc, 0xa0, 0x51,
+	0xd4, 0xd9, 0xf0, 0x03, 0x7d, 0x1c, 0x8f, 0x08, 0x13, 0xa1, 0xe3, 0x0b, 0x5f, 0x38, 0xa6, 0x36,
+	0x8a, 0xc7, 0x26, 0x32, 0x81, 0x39, 0xa5, 0x3d, 0x9d, 0xf5, 0x82, 0x3c, 0x10, 0xa7, 0x1b, 0x82,
+	0x83, 0x33, 0x01, 0x7a, 0x0a, 0x8e, 0xcf, 0x04, 0x1f, 0x17, 0xf9, 0x9d, 0xbb, 0xbf, 0xd4, 0x9e,
+	0x39, 0x8c, 0xaa, 0xe3, 0x92, 0xd8, 0xb9, 0x4a, 0x1c, 0x4b, 0x09, 0x9c, 0xbd, 0x2f, 0x35, 0x6c,
+	0x5c, 0xd1, 0x00, 0x8a, 0x49, 0x31, 0xb9, 0x36, 0x3f, 0x8c, 0x4f, 0x74, 0xa0, 0x02, 0xff, 0xda,
+	0xd3, 0xab, 0xc0, 0x57, 0x25, 0xf1, 0xfd, 0x2b, 0xc4, 0xa7, 0xf4, 0x24, 0xf0, 0xa8, 0x16, 0xb2,
+	0xd4, 0xb2, 0xfa, 0x69, 0x1e, 0xcd, 0x1e, 0x9e, 0xe1, 0x3b, 0xa8, 0x39, 0x06, 0x50, 0xb6, 0xd5,
+	0xb3, 0xd6, 0x16, 0x37, 0xff, 0x26, 0xc9, 0x62, 0xc8, 0x0b, 0x80, 0x97, 0x7c, 0x2c, 0x5c, 0x53,
+	0xc2, 0x9b, 0x08, 0xa9, 0xc0, 0xe7, 0x54, 0xc7, 0x12, 0x94, 0x3d, 0xdb, 0x6b, 0xac, 0x2d, 0x6e,
+	0x62, 0x92, 0xcc, 0x40, 0x06, 0xda, 0x1b, 0xe4, 0x25, 0xb7, 0xa0, 0xc2, 0x1d, 0xd4, 0x8e, 0x24,
+	0x04, 0x21, 0xf5, 0xc1, 0x6e, 0xf4, 0xac, 0xb5, 0xbf, 0xdc, 0xcb, 0x38, 0xa9, 0xe5, 0x4f, 0x6c,
+	0x37, 0x7b, 0x8d, 0xa4, 0x96, 0xc7, 0x78, 0x1d, 0xb5, 0x15, 0x70, 0x6f, 0x18, 0x2a, 0xdf, 0xde,
+	0x2a, 0x8e, 0x34, 0x00, 0xee, 0x1d, 0x28, 0x7f, 0x6f, 0xc6, 0x9d, 0x57, 0xe9, 0x11, 0xef, 0xa2,
+	0x65, 0x26, 0x81, 0x6a, 0x18, 0xa6, 0xfb, 0x36, 0x4d, 0x0f, 0x4c, 0xd3, 0x7f, 0x24, 0x4d, 0x91,
+	0x1d, 0x23, 0xd8, 0x35, 0x41, 0xda, 0xfe, 0x0f, 0x2b, 0xa7, 0xf0, 0x1e, 0xc2, 0x12, 0x4e, 0x80,
+	0xaa, 0x12, 0x67, 0xdb, 0x70, 0xec, 0x9c, 0xe3, 0xa6, 0x8a, 0x22, 0x68, 0x49, 0x56, 0x72, 0xc9,
+	0x40, 0x12, 0x74, 0x2c, 0x79, 0x11, 0xf4, 0xb0, 0x3c, 0x90, 0x6b, 0x04, 0xa5, 0x81, 0x64, 0x39,
+	0x85, 0xf7, 0xd1, 0x72, 0x1c, 0x79, 0x95, 0xe7, 0x7a, 0x64, 0x30, 0xdd, 0x1c, 0x73, 0x64, 0x04,
+	0x69, 0xcf, 0x6b, 0x2a, 0x75, 0x00, 0x2a, 0xa3, 0xc5, 0x85, 0x4a, 0x42, 0x3b, 0x40, 0x2b, 0xd9,
+	0x96, 0x98, 0xe0, 0x5a, 0x52, 0xa6, 0x0d, 0xef, 0xb1, 0xe1, 0xdd, 0x20, 0xf9, 0xe6, 0xb3, 0x4d,
+	0xed, 0x64, 0x9a, 0x14, 0xb6, 0xcc, 0xaa, 0xc9, 0x04, 0x97, 0x0d, 0x57, 0xc2, 0x3d, 0xa9, 0xe2,
+	0xd2, 0x01, 0x2b, 0xb8, 0xb8, 0x9a, 0xc4, 0xfb, 0x08, 0x2b, 0xd0, 0xc3, 0xe9, 0x1d, 0x35, 0xb4,
+	0xa7, 0x86, 0x76, 0x93, 0x4c, 0xd3, 0x64, 0x00, 0xfa, 0xcd, 0x65, 0x94, 0xbd, 0x00, 0x55, 0xc9,
+	0x25, 0xaf, 0x92, 0xc3, 0x64, 0xa8, 0xc5, 0x3b, 0xe0, 0xc3, 0x80, 0x8f, 0x85, 0xa1, 0x3d, 0x33,
+	0xb4, 0xff, 0x49, 0x6e, 0x63, 0xf2, 0x0a, 0x26, 0x87, 0x89, 0x24, 0xb9, 0xe3, 0xd9, 0xd6, 0x78,
+	0x39, 0x85, 0xef, 0xa1, 0x85, 0x11, 0xd5, 0xec, 0xd8, 0x00, 0x9e, 0x67, 0x17, 0x91, 0x46, 0x11,
+	0xe9, 0x27, 0xd9, 0xb4, 0xa9, 0x3d, 0xca, 0xce, 0xf8, 0x2d, 0xb2, 0xa7, 0x4b, 0x19, 0x07, 0x7e,
+	0x2c, 0xa9, 0x0e, 0x04, 0x37, 0xcd, 0x7d, 0xd3, 0x7c, 0x8b, 0x98, 0xff, 0xa7, 0xe9, 0x5a, 0xa6,
+	0xaa, 0x14, 0xf6, 0x6f, 0xfc, 0xd3, 0x4a, 0xbf, 0x85, 0x1a, 0x2a, 0x0e, 0x57, 0xbf, 0xb4, 0x50,
+	0x3b, 0xff, 0x69, 0xbc, 0x8d, 0xda, 0x21, 0x28, 0x45, 0x7d, 0xe3, 0xdb, 0xc4, 0x8e, 0x2b, 0xa5,
+	0xd9, 0xc8, 0x11, 0x0f, 0x04, 0xef, 0x37, 0xcf, 0xbf, 0xde, 0x9e, 0x71, 0x2f, 0xa5, 0x9d, 0x8f,
+	0x2d, 0xd4, 0x32, 0x95, 0x92, 0xcb, 0xac, 0x3f, 0x71, 0x59, 0xb3, 0x26, 0x97, 0xb5, 0xea, 0x72,
+	0xd9, 0x5c, 0x3d, 0x2e, 0x9b, 0xaf, 0xd9, 0x65, 0xed, 0x7a, 0x5d, 0xb6, 0x50, 0xab, 0xcb, 0x50,
+	0xad, 0x2e, 0x5b, 0xfc, 0x7d, 0x97, 0x65, 0x97, 0xbb, 0xbf, 0x74, 0x7e, 0xd1, 0xb5, 0x3e, 0x5f,
+	0x74, 0xad, 0x6f, 0x17, 0x5d, 0xeb, 0xc3, 0xf7, 0xee, 0xcc, 0x68, 0xce, 0x7c, 0xa3, 0xb6, 0x7e,
+	0x0c, 0x00, 0xac, 0xa4, 0x95, 0x24, 0x3d, 0x08, 0x00, 0x00,
 }
@@ -56,13 +56,18 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
                 ]
               }
             ],
-	    "currencies": [],
+            "currencies": [
+              {"ticker": "%s", "name": "Main token of this chain", "sig_figs": 9}
+            ],
 	    "multisig": [],
 	    "update_validators": {
               "addresses": ["%s"]
+	    },
+	    "gconf": {
+              "cash:collector_address": "%s"
 	    }
           }
-	`, addr, ticker, addr)
+	`, addr, ticker, ticker, addr, addr)
 	return []byte(opts), nil
 }
 
@@ -83,8 +88,8 @@ func GenerateApp(home string, logger log.Logger, debug bool) (abci.Application,
 	application.WithInit(app.ChainInitializers(
 		&gconf.Initializer{},
 		&multisig.Initializer{},
-		&cash.Initializer{},
 		&currency.Initializer{},
+		&cash.Initializer{Tokens: currency.NewTokenInfoBucket()},
 		&validators.Initializer{},
 	))
 
@@ -13,6 +13,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/cmd/bnsd/x/nft/username"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/x"
@@ -39,7 +40,7 @@ func Authenticator() x.Authenticator {
 func Chain(authFn x.Authenticator) app.Decorators {
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
-	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
+	var ctrl cash.Controller = currency.NewController(cash.NewController(cash.NewBucket()))
 
 	return app.ChainDecorators(
 		utils.NewLogging(),
@@ -68,7 +69,7 @@ func Router(authFn x.Authenticator, issuer weave.Address, nftBuckets map[string]
 
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
-	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
+	var ctrl cash.Controller = currency.NewController(cash.NewController(cash.NewBucket()))
 
 	cash.RegisterRoutes(r, authFn, ctrl)
 	escrow.RegisterRoutes(r, authFn, ctrl)
@@ -79,12 +80,13 @@ func Router(authFn x.Authenticator, issuer weave.Address, nftBuckets map[string]
 	username.RegisterRoutes(r, authFn, issuer)
 	validators.RegisterRoutes(r, authFn, validators.NewController())
 	base.RegisterRoutes(r, authFn, issuer, nftBuckets)
+	gconf.RegisterRoutes(r, authFn)
 	return r
 }
 
 // QueryRouter returns a default query router,
 // allowing access to "/wallets", "/auth", "/", "/escrows", "/nft/usernames",
-// "/nft/blockchains", "/nft/tickers", "/validators"
+// "/nft/blockchains", "/nft/tickers", "/validators", "/gconf"
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 
@@ -96,6 +98,7 @@ func QueryRouter() weave.QueryRouter {
 		username.RegisterQuery,
 		validators.RegisterQuery,
 		orm.RegisterQuery,
+		gconf.RegisterQuery,
 		currency.RegisterQuery,
 	)
 	return r
@@ -127,10 +130,19 @@ func Application(name string, h weave.Handler,
 	}
 	RegisterNft()
 	store := app.NewStoreApp(name, kv, QueryRouter(), ctx)
-	base := app.NewBaseApp(store, tx, h, nil, debug)
+	base := app.NewBaseApp(store, tx, h, Migrations(), debug)
 	return base, nil
 }
 
+// Migrations returns the state migrations of all extensions, they are
+// applied at the beginning of the first block processed by this version.
+func Migrations() orm.Migrations {
+	var ms orm.Migrations
+	ms = append(ms, escrow.Migrations()...)
+	ms = append(ms, currency.Migrations()...)
+	return ms
+}
+
 // CommitKVStore returns an initialized KVStore that persists
 // the data to the named path.
 func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
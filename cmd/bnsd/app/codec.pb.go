@@ -17,6 +17,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import username "github.com/iov-one/weave/cmd/bnsd/x/nft/username"
+import gconf "github.com/iov-one/weave/gconf"
 import cash "github.com/iov-one/weave/x/cash"
 import currency "github.com/iov-one/weave/x/currency"
 import escrow "github.com/iov-one/weave/x/escrow"
@@ -71,6 +72,7 @@ type Tx struct {
 	//	*Tx_IssueUsernameNftMsg
 	//	*Tx_AddUsernameAddressNftMsg
 	//	*Tx_RemoveUsernameAddressMsg
+	//	*Tx_UpdateConfigurationMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -127,6 +129,9 @@ type Tx_AddUsernameAddressNftMsg struct {
 type Tx_RemoveUsernameAddressMsg struct {
 	RemoveUsernameAddressMsg *username.RemoveChainAddressMsg `protobuf:"bytes,65,opt,name=remove_username_address_msg,json=removeUsernameAddressMsg,oneof"`
 }
+type Tx_UpdateConfigurationMsg struct {
+	UpdateConfigurationMsg *gconf.UpdateConfigurationMsg `protobuf:"bytes,66,opt,name=update_configuration_msg,json=updateConfigurationMsg,oneof"`
+}
 
 func (*Tx_SendMsg) isTx_Sum()                  {}
 func (*Tx_CreateEscrowMsg) isTx_Sum()          {}
@@ -142,6 +147,7 @@ func (*Tx_RemoveApprovalMsg) isTx_Sum()        {}
 func (*Tx_IssueUsernameNftMsg) isTx_Sum()      {}
 func (*Tx_AddUsernameAddressNftMsg) isTx_Sum() {}
 func (*Tx_RemoveUsernameAddressMsg) isTx_Sum() {}
+func (*Tx_UpdateConfigurationMsg) isTx_Sum()   {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -276,6 +282,13 @@ func (m *Tx) GetRemoveUsernameAddressMsg() *username.RemoveChainAddressMsg {
 	return nil
 }
 
+func (m *Tx) GetUpdateConfigurationMsg() *gconf.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_UpdateConfigurationMsg); ok {
+		return x.UpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -293,6 +306,7 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_IssueUsernameNftMsg)(nil),
 		(*Tx_AddUsernameAddressNftMsg)(nil),
 		(*Tx_RemoveUsernameAddressMsg)(nil),
+		(*Tx_UpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -370,6 +384,11 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.RemoveUsernameAddressMsg); err != nil {
 			return err
 		}
+	case *Tx_UpdateConfigurationMsg:
+		_ = b.EncodeVarint(66<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -492,6 +511,14 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_RemoveUsernameAddressMsg{msg}
 		return true, err
+	case 66: // sum.update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gconf.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_UpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -571,6 +598,11 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(65<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_UpdateConfigurationMsg:
+		s := proto.Size(x.UpdateConfigurationMsg)
+		n += proto.SizeVarint(66<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -866,6 +898,22 @@ func (m *Tx_RemoveUsernameAddressMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Tx_UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateConfigurationMsg.Size()))
+		n17, err := m.UpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1030,6 +1078,15 @@ func (m *Tx_RemoveUsernameAddressMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Tx_UpdateConfigurationMsg) Size() (n int) {
+	var l int
+	_ = l
+	if m.UpdateConfigurationMsg != nil {
+		l = m.UpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 
 func sovCodec(x uint64) (n int) {
 	for {
@@ -1645,6 +1702,38 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Tx_RemoveUsernameAddressMsg{v}
 			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gconf.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UpdateConfigurationMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1774,50 +1863,52 @@ var (
 func init() { proto.RegisterFile("app/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x4e, 0xdb, 0x4a,
-	0x10, 0xc7, 0x09, 0xe1, 0x9c, 0xc3, 0x59, 0xce, 0x29, 0xe0, 0x48, 0x34, 0x0d, 0x28, 0x4d, 0x7b,
-	0x85, 0xa8, 0x58, 0xab, 0xd0, 0xef, 0x2f, 0x1a, 0x10, 0x15, 0x48, 0x80, 0xaa, 0x04, 0x7a, 0x59,
-	0x77, 0xe3, 0x1d, 0x1b, 0xab, 0xf1, 0xae, 0xb5, 0xbb, 0x4e, 0xe8, 0x5b, 0xf4, 0x8d, 0x7a, 0xdb,
-	0xcb, 0x3e, 0x42, 0x45, 0x5f, 0xa4, 0xf2, 0xae, 0x1d, 0xbc, 0x6e, 0x1b, 0x71, 0x97, 0x99, 0xf9,
-	0xff, 0x7f, 0x3b, 0x33, 0xbb, 0x31, 0x5a, 0x24, 0x49, 0xe2, 0xfa, 0x9c, 0x82, 0x8f, 0x13, 0xc1,
-	0x15, 0x77, 0xea, 0x24, 0x49, 0x5a, 0x9b, 0x61, 0xa4, 0xce, 0xd3, 0x01, 0xf6, 0x79, 0xec, 0x86,
-	0x3c, 0xe4, 0xae, 0xae, 0x0d, 0xd2, 0x40, 0x47, 0x3a, 0xd0, 0xbf, 0x8c, 0xa7, 0xf5, 0xa2, 0x24,
-	0x8f, 0xf8, 0x68, 0x93, 0x33, 0x70, 0xc7, 0x40, 0x46, 0xe0, 0xfa, 0x31, 0x75, 0x07, 0x4c, 0x52,
-	0xf7, 0xc2, 0x65, 0x81, 0x72, 0x53, 0x09, 0x82, 0x91, 0x18, 0xca, 0x27, 0xb6, 0xee, 0xfd, 0xd1,
-	0x7d, 0xe1, 0xfa, 0x44, 0x9e, 0x5b, 0x62, 0x77, 0x9a, 0x38, 0x15, 0x02, 0x98, 0xff, 0xc9, 0x32,
-	0x6c, 0x4e, 0x31, 0x80, 0xf4, 0x05, 0x1f, 0x5f, 0x9b, 0x1f, 0xa7, 0x43, 0x15, 0xc9, 0x28, 0xb4,
-	0x0c, 0x1b, 0x53, 0x0c, 0xd9, 0xc8, 0xd7, 0x9d, 0x54, 0x46, 0xa1, 0xb4, 0xc4, 0xf7, 0xa7, 0x88,
-	0x47, 0x64, 0x18, 0x51, 0xa2, 0xb8, 0xb0, 0x2c, 0x77, 0xbf, 0xfc, 0x8b, 0x66, 0x4f, 0x2f, 0x9c,
-	0x3b, 0x68, 0x2e, 0x00, 0x90, 0xcd, 0x5a, 0xa7, 0xb6, 0xbe, 0xb0, 0xf5, 0x3f, 0xce, 0x96, 0x88,
-	0xdf, 0x00, 0x1c, 0xb2, 0x80, 0xf7, 0x74, 0xc9, 0xd9, 0x42, 0x48, 0x46, 0x21, 0x23, 0x2a, 0x15,
-	0x20, 0x9b, 0xb3, 0x9d, 0xfa, 0xfa, 0xc2, 0x96, 0x83, 0xb3, 0x1e, 0x70, 0x5f, 0xd1, 0x7e, 0x51,
-	0xea, 0x95, 0x54, 0x4e, 0x0b, 0xcd, 0x27, 0x02, 0xa2, 0x98, 0x84, 0xd0, 0xac, 0x77, 0x6a, 0xeb,
-	0xff, 0xf5, 0x26, 0x71, 0x56, 0x2b, 0xb6, 0xd3, 0x9c, 0xeb, 0xd4, 0xb3, 0x5a, 0x11, 0x3b, 0x1b,
-	0x68, 0x5e, 0x02, 0xa3, 0x5e, 0x2c, 0xc3, 0xe6, 0x76, 0xb9, 0xa5, 0x3e, 0x30, 0x7a, 0x2c, 0xc3,
-	0x83, 0x99, 0xde, 0x3f, 0xd2, 0xfc, 0x74, 0xf6, 0xd1, 0xb2, 0x2f, 0x80, 0x28, 0xf0, 0xcc, 0xdd,
-	0x68, 0xd3, 0x03, 0x6d, 0xba, 0x89, 0x4d, 0x0a, 0xef, 0x69, 0xc1, 0xbe, 0x0e, 0x8c, 0x7d, 0xd1,
-	0xb7, 0x53, 0xce, 0x01, 0x72, 0x04, 0x0c, 0x81, 0x48, 0x8b, 0xf3, 0x50, 0x73, 0x9a, 0x05, 0xa7,
-	0x67, 0x14, 0x65, 0xd0, 0x92, 0xa8, 0xe4, 0xb2, 0x86, 0x04, 0xa8, 0x54, 0xb0, 0x32, 0xe8, 0x91,
-	0xdd, 0x50, 0x4f, 0x0b, 0xac, 0x86, 0x84, 0x9d, 0x72, 0x8e, 0xd0, 0x72, 0x9a, 0xd0, 0xca, 0x5c,
-	0x8f, 0x35, 0xa6, 0x5d, 0x60, 0xce, 0xb4, 0xc0, 0x78, 0xde, 0x12, 0xa1, 0x22, 0x90, 0x39, 0x2d,
-	0x2d, 0x55, 0x32, 0xda, 0x31, 0x6a, 0xe4, 0x5b, 0xf2, 0x39, 0x53, 0x82, 0xf8, 0x4a, 0xf3, 0x9e,
-	0x68, 0xde, 0x2a, 0x2e, 0x36, 0x9f, 0x6f, 0x6a, 0x2f, 0xd7, 0x18, 0x58, 0xbe, 0xdf, 0x52, 0x32,
-	0xc3, 0xe5, 0xcd, 0x59, 0xb8, 0xa7, 0x55, 0x9c, 0x69, 0xb0, 0x82, 0x4b, 0xab, 0x49, 0xe7, 0x08,
-	0x39, 0x12, 0x94, 0x77, 0xf5, 0x46, 0x35, 0xed, 0x99, 0xa6, 0xad, 0xe1, 0xab, 0x34, 0xee, 0x83,
-	0x7a, 0x37, 0x89, 0xf2, 0x0b, 0x90, 0x95, 0x5c, 0x76, 0x95, 0x0c, 0xc6, 0x9e, 0xe2, 0x1f, 0x81,
-	0x79, 0x11, 0x0b, 0xb8, 0xa6, 0x3d, 0xd7, 0xb4, 0x5b, 0xb8, 0xf8, 0xcb, 0xe3, 0x13, 0x18, 0x9f,
-	0x66, 0x92, 0xec, 0x8d, 0xe7, 0x5b, 0x63, 0x76, 0xca, 0xd9, 0x41, 0x4b, 0x84, 0x52, 0x8f, 0x24,
-	0x89, 0xe0, 0x23, 0x32, 0xd4, 0x9c, 0x97, 0x9a, 0xd3, 0xc0, 0x2c, 0x50, 0xb8, 0x4b, 0x69, 0x37,
-	0xaf, 0x19, 0xc2, 0x0d, 0x62, 0x65, 0x9c, 0x03, 0xd4, 0x10, 0x10, 0xf3, 0x11, 0xd8, 0x8c, 0x57,
-	0x9a, 0xb1, 0xa2, 0x19, 0x3d, 0x5d, 0xb7, 0x31, 0xcb, 0xa2, 0x9a, 0x74, 0x4e, 0xd0, 0x4a, 0x24,
-	0x65, 0x0a, 0x5e, 0xf1, 0x41, 0xf4, 0x58, 0x60, 0x96, 0xbe, 0x93, 0x3f, 0xad, 0xa2, 0x80, 0x0f,
-	0x33, 0x9d, 0x9e, 0xc3, 0xd0, 0x1a, 0xda, 0x78, 0x96, 0x97, 0x4f, 0x02, 0xbd, 0xf2, 0xf7, 0x68,
-	0x2d, 0x1b, 0x6d, 0x42, 0x23, 0x94, 0x0a, 0x90, 0x72, 0x42, 0x7d, 0x9d, 0x2f, 0x7f, 0x42, 0xed,
-	0x52, 0xba, 0x77, 0x4e, 0x22, 0xd6, 0x35, 0x42, 0x83, 0x6e, 0x12, 0x4a, 0x0b, 0x70, 0x5e, 0xc8,
-	0xf9, 0x1f, 0xd0, 0x6a, 0x3e, 0xf9, 0x2f, 0x47, 0x64, 0xf8, 0xae, 0xc6, 0xdf, 0xbe, 0xc2, 0x9b,
-	0x35, 0xfc, 0xe6, 0x04, 0x43, 0xa9, 0x1c, 0x72, 0x2c, 0xc3, 0xdd, 0xbf, 0x50, 0x5d, 0xa6, 0xf1,
-	0xee, 0xd2, 0xd7, 0xcb, 0x76, 0xed, 0xdb, 0x65, 0xbb, 0xf6, 0xfd, 0xb2, 0x5d, 0xfb, 0xfc, 0xa3,
-	0x3d, 0x33, 0xf8, 0x5b, 0x7f, 0xda, 0xb6, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x09, 0x1c, 0x7c,
-	0x3c, 0xa9, 0x06, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x4e, 0xe3, 0x46,
+	0x14, 0xc7, 0x09, 0xa1, 0x2d, 0x1a, 0xda, 0x42, 0x1c, 0x89, 0xba, 0x81, 0xa6, 0x69, 0xaf, 0x10,
+	0x15, 0x63, 0x15, 0xfa, 0xfd, 0x45, 0x03, 0xa2, 0x02, 0x09, 0x50, 0x95, 0x40, 0xa5, 0xde, 0xd4,
+	0x9d, 0x78, 0x8e, 0x8d, 0xb5, 0xf1, 0x8c, 0x35, 0x33, 0x4e, 0xd8, 0xb7, 0xd8, 0xeb, 0x7d, 0xa2,
+	0xbd, 0xdc, 0x47, 0x58, 0xb1, 0x2f, 0xb2, 0xf2, 0x19, 0x3b, 0x89, 0xbd, 0x80, 0xb8, 0xf3, 0x39,
+	0xff, 0xff, 0xf9, 0xcd, 0x39, 0xc7, 0x13, 0x87, 0xac, 0xb3, 0x34, 0xf5, 0x02, 0xc9, 0x21, 0xa0,
+	0xa9, 0x92, 0x46, 0x3a, 0x4d, 0x96, 0xa6, 0x9d, 0xbd, 0x28, 0x36, 0x37, 0xd9, 0x88, 0x06, 0x32,
+	0xf1, 0x22, 0x19, 0x49, 0x0f, 0xb5, 0x51, 0x16, 0x62, 0x84, 0x01, 0x3e, 0xd9, 0x9a, 0xce, 0x6f,
+	0x0b, 0xf6, 0x58, 0x4e, 0xf6, 0xa4, 0x00, 0x6f, 0x0a, 0x6c, 0x02, 0x5e, 0x90, 0x70, 0x6f, 0x24,
+	0x34, 0xf7, 0x6e, 0x3d, 0x11, 0x1a, 0x2f, 0xd3, 0xa0, 0x04, 0x4b, 0x60, 0xf1, 0xc4, 0xce, 0xee,
+	0x83, 0xd5, 0x51, 0x20, 0x45, 0x58, 0xf1, 0x7e, 0xf3, 0xa0, 0xf7, 0xd6, 0x0b, 0x98, 0xbe, 0xa9,
+	0x98, 0xbd, 0xc7, 0xcc, 0x99, 0x52, 0x20, 0x82, 0xe7, 0x95, 0x82, 0xbd, 0x47, 0x0a, 0x40, 0x07,
+	0x4a, 0x4e, 0x9f, 0xcc, 0x4f, 0xb2, 0xb1, 0x89, 0x75, 0x1c, 0x3d, 0x71, 0x52, 0xbb, 0x9e, 0xa7,
+	0x4e, 0xaa, 0xe3, 0x48, 0x57, 0xcc, 0xdf, 0x3e, 0x62, 0x9e, 0xb0, 0x71, 0xcc, 0x99, 0x91, 0xaa,
+	0x52, 0xf2, 0xf5, 0x4b, 0x42, 0x96, 0xaf, 0x6e, 0x9d, 0xaf, 0xc8, 0x4a, 0x08, 0xa0, 0xdd, 0x46,
+	0xaf, 0xb1, 0xb3, 0xb6, 0xff, 0x09, 0xcd, 0x97, 0x48, 0xff, 0x02, 0x38, 0x13, 0xa1, 0x1c, 0xa0,
+	0xe4, 0xec, 0x13, 0xa2, 0xe3, 0x48, 0x30, 0x93, 0x29, 0xd0, 0xee, 0x72, 0xaf, 0xb9, 0xb3, 0xb6,
+	0xef, 0xd0, 0xbc, 0x07, 0x3a, 0x34, 0x7c, 0x58, 0x4a, 0x83, 0x05, 0x97, 0xd3, 0x21, 0xab, 0xa9,
+	0x82, 0x38, 0x61, 0x11, 0xb8, 0xcd, 0x5e, 0x63, 0xe7, 0xe3, 0xc1, 0x2c, 0xce, 0xb5, 0x72, 0x3b,
+	0xee, 0x4a, 0xaf, 0x99, 0x6b, 0x65, 0xec, 0xec, 0x92, 0x55, 0x0d, 0x82, 0xfb, 0x89, 0x8e, 0xdc,
+	0x83, 0xc5, 0x96, 0x86, 0x20, 0xf8, 0x85, 0x8e, 0x4e, 0x97, 0x06, 0x1f, 0x69, 0xfb, 0xe8, 0x9c,
+	0x90, 0x56, 0xa0, 0x80, 0x19, 0xf0, 0xed, 0xbb, 0xc1, 0xa2, 0xef, 0xb0, 0xe8, 0x33, 0x6a, 0x53,
+	0xf4, 0x18, 0x0d, 0x27, 0x18, 0xd8, 0xf2, 0xf5, 0xa0, 0x9a, 0x72, 0x4e, 0x89, 0xa3, 0x60, 0x0c,
+	0x4c, 0x57, 0x38, 0xdf, 0x23, 0xc7, 0x2d, 0x39, 0x03, 0xeb, 0x58, 0x04, 0x6d, 0xa8, 0x5a, 0x2e,
+	0x6f, 0x48, 0x81, 0xc9, 0x94, 0x58, 0x04, 0xfd, 0x50, 0x6d, 0x68, 0x80, 0x86, 0x4a, 0x43, 0xaa,
+	0x9a, 0x72, 0xce, 0x49, 0x2b, 0x4b, 0x79, 0x6d, 0xae, 0x1f, 0x11, 0xd3, 0x2d, 0x31, 0xd7, 0x68,
+	0xb0, 0x35, 0x7f, 0x33, 0x65, 0x62, 0xd0, 0x05, 0x2d, 0x5b, 0x50, 0x72, 0xda, 0x05, 0x69, 0x17,
+	0x5b, 0x0a, 0xa4, 0x30, 0x8a, 0x05, 0x06, 0x79, 0x3f, 0x21, 0x6f, 0x8b, 0x96, 0x9b, 0x2f, 0x36,
+	0x75, 0x5c, 0x78, 0x2c, 0xac, 0x15, 0xd4, 0x93, 0x39, 0xae, 0x68, 0xae, 0x82, 0xfb, 0xb9, 0x8e,
+	0xb3, 0x0d, 0xd6, 0x70, 0x59, 0x3d, 0xe9, 0x9c, 0x13, 0x47, 0x83, 0xf1, 0xe7, 0x77, 0x14, 0x69,
+	0xbf, 0x20, 0x6d, 0x9b, 0xce, 0xd3, 0x74, 0x08, 0xe6, 0x9f, 0x59, 0x54, 0xbc, 0x00, 0x5d, 0xcb,
+	0xe5, 0xaf, 0x52, 0xc0, 0xd4, 0x37, 0xf2, 0x19, 0x08, 0x3f, 0x16, 0xa1, 0x44, 0xda, 0xaf, 0x48,
+	0xfb, 0x9c, 0x96, 0x3f, 0x79, 0x7a, 0x09, 0xd3, 0xab, 0xdc, 0x92, 0xdf, 0xf1, 0x62, 0x6b, 0xa2,
+	0x9a, 0x72, 0x0e, 0xc9, 0x06, 0xe3, 0xdc, 0x67, 0x69, 0xaa, 0xe4, 0x84, 0x8d, 0x91, 0xf3, 0x3b,
+	0x72, 0xda, 0x54, 0x84, 0x86, 0xf6, 0x39, 0xef, 0x17, 0x9a, 0x25, 0x7c, 0xca, 0x2a, 0x19, 0xe7,
+	0x94, 0xb4, 0x15, 0x24, 0x72, 0x02, 0x55, 0xc6, 0x1f, 0xc8, 0xd8, 0x44, 0xc6, 0x00, 0xf5, 0x2a,
+	0xa6, 0xa5, 0xea, 0x49, 0xe7, 0x92, 0x6c, 0xc6, 0x5a, 0x67, 0xe0, 0x97, 0x1f, 0x4f, 0x5f, 0x84,
+	0x76, 0xe9, 0x87, 0xc5, 0xd5, 0x2a, 0x05, 0x7a, 0x96, 0xfb, 0x70, 0x0e, 0x4b, 0x6b, 0x63, 0xe1,
+	0x75, 0x21, 0x5f, 0x86, 0xb8, 0xf2, 0xff, 0xc8, 0x76, 0x3e, 0xda, 0x8c, 0xc6, 0x38, 0x57, 0xa0,
+	0xf5, 0x8c, 0xfa, 0x67, 0xb1, 0xfc, 0x19, 0xb5, 0xcf, 0xf9, 0xf1, 0x0d, 0x8b, 0x45, 0xdf, 0x1a,
+	0x2d, 0xda, 0x65, 0x9c, 0x97, 0xe0, 0x42, 0x28, 0xf8, 0xff, 0x93, 0xad, 0x62, 0xf2, 0xf7, 0x8e,
+	0xc8, 0xf1, 0x7d, 0xc4, 0x7f, 0x39, 0xc7, 0xdb, 0x35, 0xdc, 0x73, 0x82, 0xa5, 0xd4, 0x0e, 0xc9,
+	0x4f, 0xf8, 0x97, 0xb8, 0xf3, 0x3b, 0x18, 0xc6, 0x51, 0xa6, 0x98, 0x89, 0xa5, 0x40, 0xfc, 0x11,
+	0xe2, 0xbf, 0xa0, 0xf8, 0xd7, 0x31, 0xbf, 0x85, 0x73, 0x97, 0x85, 0x6f, 0x66, 0xf7, 0x2a, 0x47,
+	0x1f, 0x90, 0xa6, 0xce, 0x92, 0xa3, 0x8d, 0x57, 0x77, 0xdd, 0xc6, 0xeb, 0xbb, 0x6e, 0xe3, 0xcd,
+	0x5d, 0xb7, 0xf1, 0xe2, 0x6d, 0x77, 0x69, 0xf4, 0x21, 0x7e, 0x35, 0x0f, 0xde, 0x0d, 0x00, 0xdd,
+	0xfe, 0x76, 0xd4, 0x30, 0x07, 0x00, 0x00,
 }
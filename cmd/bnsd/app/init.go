@@ -59,16 +59,21 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
                 ]
               }
             ],
-            "currencies": [],
+            "currencies": [
+              {"ticker": "%s", "name": "Main token of this chain", "sig_figs": 9}
+            ],
             "nfts": {
               "blockchains": []
             },
             "multisig": [],
 	    "update_validators": {
               "addresses": ["%s"]
+	    },
+	    "gconf": {
+              "cash:collector_address": "%s"
 	    }
           }
-	`, addr, ticker, addr)
+	`, addr, ticker, ticker, addr, addr)
 	return []byte(opts), nil
 }
 
@@ -96,8 +101,8 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&gconf.Initializer{},
 		&multisig.Initializer{},
-		&cash.Initializer{},
 		&currency.Initializer{},
+		&cash.Initializer{Tokens: currency.NewTokenInfoBucket()},
 		&validators.Initializer{},
 	))
 	application.WithLogger(logger)
@@ -113,7 +118,7 @@ func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Appli
 	ctx := context.Background()
 	RegisterNft()
 	store := app.NewStoreApp("bnsd", kv, QueryRouter(), ctx)
-	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug)
+	base := app.NewBaseApp(store, TxDecoder, stack, Migrations(), debug)
 	return DecorateApp(base, logger)
 }
 
@@ -77,9 +77,16 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 		Coins   x.Coins       `json:"coins"`
 	}
 
+	type token struct {
+		Ticker  string `json:"ticker"`
+		Name    string `json:"name"`
+		SigFigs int32  `json:"sig_figs"`
+	}
+
 	state := struct {
-		Cash  []wallet               `json:"cash"`
-		Gconf map[string]interface{} `json:"gconf"`
+		Cash       []wallet               `json:"cash"`
+		Currencies []token                `json:"currencies"`
+		Gconf      map[string]interface{} `json:"gconf"`
 	}{
 		Cash: []wallet{
 			{
@@ -90,8 +97,12 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 				},
 			},
 		},
+		Currencies: []token{
+			{Ticker: "ETH", Name: "Ether", SigFigs: 9},
+			{Ticker: "FRNK", Name: "Frank", SigFigs: 9},
+		},
 		Gconf: map[string]interface{}{
-			cash.GconfCollectorAddress: "fake-collector-address",
+			cash.GconfCollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
 			cash.GconfMinimalFee:       x.Coin{Whole: 0}, // no fee
 		},
 	}
@@ -3,12 +3,15 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/pkg/errors"
@@ -418,6 +421,56 @@ func (b *BnsClient) Currencies() (CurrenciesResponse, error) {
 	return out, nil
 }
 
+// ParseCoin parses a human readable coin, eg. "12.5 IOV" or
+// "1 chain-1/ETH" (see x.ParseCoin). The token must be registered on
+// the chain by its ID, and the value may not be more precise than its
+// significant figures allow.
+func (b *BnsClient) ParseCoin(s string) (x.Coin, error) {
+	c, err := x.ParseCoin(s)
+	if err != nil {
+		return c, err
+	}
+	id := c.ID()
+	resp, err := b.AbciQuery("/tokens", []byte(id))
+	if err != nil {
+		return c, errors.Wrapf(err, "failed to query for %q token", id)
+	}
+	if len(resp.Models) == 0 {
+		return c, currency.ErrUnknownToken(id)
+	}
+	var ti currency.TokenInfo
+	if err := ti.Unmarshal(resp.Models[0].Value); err != nil {
+		return c, errors.Wrapf(err, "failed to unmarshal %q token", id)
+	}
+	return c, ti.ValidateCoin(c)
+}
+
+// Config returns the JSON encoded value of a configuration property
+// (see gconf), eg. "cash:minimal_fee".
+// If the property is not set, it returns (nil, nil).
+func (b *BnsClient) Config(name string) (json.RawMessage, error) {
+	resp, err := b.AbciQuery("/gconf", []byte(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query for %q configuration", name)
+	}
+	if len(resp.Models) == 0 {
+		return nil, nil
+	}
+	return json.RawMessage(resp.Models[0].Value), nil
+}
+
+// MinimalFee returns the lowest fee accepted by the chain.
+// A zero coin means that no fee is required.
+func (b *BnsClient) MinimalFee() (x.Coin, error) {
+	var fee x.Coin
+	raw, err := b.Config(cash.GconfMinimalFee)
+	if err != nil || raw == nil {
+		return fee, err
+	}
+	err = json.Unmarshal(raw, &fee)
+	return fee, errors.Wrap(err, "cannot decode minimal fee")
+}
+
 // UserResponse is a response on a query for a User
 type UserResponse struct {
 	Address  weave.Address
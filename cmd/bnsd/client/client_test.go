@@ -1,11 +1,14 @@
 package client
 
 import (
+	"encoding/json"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/rpc/client"
@@ -228,3 +231,28 @@ func TestSendMultipleTx(t *testing.T) {
 	assert.True(t, resp.Response.Height > prepH+1)
 	assert.True(t, resp2.Response.Height > prepH+1)
 }
+
+func TestConfig(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+
+	raw, err := bcp.Config(cash.GconfCollectorAddress)
+	require.NoError(t, err)
+	var collector weave.Address
+	require.NoError(t, json.Unmarshal(raw, &collector))
+	assert.Equal(t, weave.NewAddress([]byte("fake-collector-address")), collector)
+
+	raw, err = bcp.Config("cash:no_such_property")
+	require.NoError(t, err)
+	assert.Nil(t, raw)
+
+	// genesis requires no fee, so none is set
+	fee, err := bcp.MinimalFee()
+	require.NoError(t, err)
+	assert.True(t, fee.IsZero())
+
+	src := faucet.PublicKey().Address()
+	tx, err := bcp.NewSendTx(src, GenPrivateKey().PublicKey().Address(), x.Coin{Whole: 1, Ticker: initBalance.Ticker}, "fee")
+	require.NoError(t, err)
+	assert.Nil(t, tx.Fees)
+}
@@ -21,16 +21,26 @@ func GenPrivateKey() *PrivateKey {
 	return crypto.GenPrivKeyEd25519()
 }
 
+// GenSecp256k1PrivateKey creates a new random secp256k1 key.
+func GenSecp256k1PrivateKey() *PrivateKey {
+	return crypto.GenPrivKeySecp256k1()
+}
+
+// DecodePrivateKeyFromSeed reads a hex encoded raw private key.
+// 64 bytes are read as an ed25519 key, 32 bytes as a secp256k1 key.
 func DecodePrivateKeyFromSeed(hexSeed string) (*PrivateKey, error) {
 	data, err := hex.DecodeString(hexSeed)
 	if err != nil {
 		return nil, err
 	}
-	if len(data) != 64 {
+	switch len(data) {
+	case 64:
+		return &PrivateKey{Priv: &crypto.PrivateKey_Ed25519{Ed25519: data}}, nil
+	case 32:
+		return &PrivateKey{Priv: &crypto.PrivateKey_Secp256K1{Secp256K1: data}}, nil
+	default:
 		return nil, errors.New("invalid key")
 	}
-	key := &PrivateKey{Priv: &crypto.PrivateKey_Ed25519{Ed25519: data}}
-	return key, nil
 }
 
 // DecodePrivateKey reads a hex string created by EncodePrivateKey
@@ -169,3 +169,22 @@ func TestDecodesCliKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.EqualValues(t, address, key.PublicKey().Address())
 }
+
+func TestSecp256k1Keys(t *testing.T) {
+	private := GenSecp256k1PrivateKey()
+	assert.NotEqual(t, private, GenSecp256k1PrivateKey())
+
+	enc, err := EncodePrivateKey(private)
+	require.NoError(t, err)
+	dec, err := DecodePrivateKey(enc)
+	require.NoError(t, err)
+	assert.Equal(t, private, dec)
+
+	seed := hex.EncodeToString(private.GetSecp256K1())
+	fromSeed, err := DecodePrivateKeyFromSeed(seed)
+	require.NoError(t, err)
+	assert.Equal(t, private.PublicKey(), fromSeed.PublicKey())
+
+	_, err = DecodePrivateKeyFromSeed(seed[2:])
+	assert.Error(t, err)
+}
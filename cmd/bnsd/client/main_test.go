@@ -84,8 +84,15 @@ func initGenesis(filename string, addr weave.Address) error {
 				"coins":   x.Coins{&initBalance},
 			},
 		},
+		"currencies": []interface{}{
+			map[string]interface{}{
+				"ticker":   initBalance.Ticker,
+				"name":     "Test token",
+				"sig_figs": 9,
+			},
+		},
 		"gconf": map[string]interface{}{
-			cash.GconfCollectorAddress: "fake-collector-address",
+			cash.GconfCollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
 			cash.GconfMinimalFee:       x.Coin{}, // no fee
 		},
 	})
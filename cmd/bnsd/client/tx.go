@@ -28,6 +28,37 @@ func BuildSendTx(src, dest weave.Address, amount x.Coin, memo string) *app.Tx {
 		}}
 }
 
+// NewSendTx creates an unsigned tx to move tokens, like BuildSendTx,
+// with the minimal fee of the chain paid by src, see PrefillFees
+func (b *BnsClient) NewSendTx(src, dest weave.Address, amount x.Coin, memo string) (*app.Tx, error) {
+	tx := BuildSendTx(src, dest, amount, memo)
+	if err := b.PrefillFees(tx, src); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// PrefillFees sets the fee of the tx to the minimal fee of the chain,
+// paid by payer. A tx that already has a fee is not modified, neither
+// is a tx when the chain requires no fee.
+func (b *BnsClient) PrefillFees(tx *app.Tx, payer weave.Address) error {
+	if !x.IsEmpty(tx.Fees.GetFees()) {
+		return nil
+	}
+	fee, err := b.MinimalFee()
+	if err != nil {
+		return err
+	}
+	if fee.IsZero() {
+		return nil
+	}
+	tx.Fees = &cash.FeeInfo{
+		Payer: payer,
+		Fees:  &fee,
+	}
+	return nil
+}
+
 // SignTx modifies the tx in-place, adding signatures
 func SignTx(tx *app.Tx, signer *PrivateKey, chainID string, nonce int64) error {
 	sig, err := sigs.SignTx(signer, tx, chainID, nonce)
@@ -83,6 +83,28 @@ type MaybeCoin struct {
 	Issuer     *string `json:"issuer,omitempty"`
 }
 
+// UnmarshalJSON accepts a coin as a JSON object, which may omit
+// some fields, or as a complete coin string, eg. "12.5 IOV".
+func (m *MaybeCoin) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		c, err := x.ParseCoin(s)
+		if err != nil {
+			return err
+		}
+		*m = MaybeCoin{
+			Whole:      &c.Whole,
+			Fractional: &c.Fractional,
+			Ticker:     &c.Ticker,
+			Issuer:     &c.Issuer,
+		}
+		return nil
+	}
+	// the alias has no methods, so this does not recurse
+	type maybeCoinAlias MaybeCoin
+	return json.Unmarshal(raw, (*maybeCoinAlias)(m))
+}
+
 // WithDefaults fills the gaps in a maybe coin by replacing
 // missing values with default ones
 func (m MaybeCoin) WithDefaults(defaults x.Coin) x.Coin {
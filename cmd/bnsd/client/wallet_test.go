@@ -249,3 +249,36 @@ func TestKeyGen(t *testing.T) {
 		assert.Len(t, w.Keys, useCase.N)
 	}
 }
+
+func TestHumanReadableCoins(t *testing.T) {
+	actual := wsFromJSON(t, json.RawMessage(`{"cash": [{
+		"address": "3afcdab4cfbf066e959d139251c8f0ee91e99d5a",
+		"coins": ["12.5 IOV", {"ticker": "ETH"}]
+	}]}`))
+	expected := WalletStore{
+		Wallets: []cash.GenesisAccount{
+			{
+				Address: toWeaveAddress(t, "3AFCDAB4CFBF066E959D139251C8F0EE91E99D5A"),
+				Set: cash.Set{
+					Coins: []*x.Coin{
+						{
+							Ticker:     "IOV",
+							Whole:      12,
+							Fractional: 500000000,
+						},
+						{
+							Ticker:     "ETH",
+							Whole:      123456789,
+							Fractional: 5555555,
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.EqualValues(t, expected, actual, ToString(expected), ToString(actual))
+
+	w := WalletStore{}
+	err := w.LoadFromJSON(json.RawMessage(`{"cash": [{"coins": ["12.5"]}]}`), defaults)
+	assert.True(t, x.IsInvalidCoinErr(err))
+}
@@ -27,14 +27,17 @@ func init() {
 
 func helpMessage() {
 	fmt.Println("bnsd")
-	fmt.Println("          Blockchain Name Service node")
+	fmt.Println("             Blockchain Name Service node")
 	fmt.Println("")
-	fmt.Println("help      Print this message")
-	fmt.Println("init      Initialize app options in genesis file")
-	fmt.Println("start     Run the abci server")
-	fmt.Println("getblock  Extract a block from blockchain.db")
-	fmt.Println("retry     Run last block again to ensure it produces same result")
-	fmt.Println("version   Print the app version")
+	fmt.Println("help         Print this message")
+	fmt.Println("init         Initialize app options in genesis file")
+	fmt.Println("start        Run the abci server")
+	fmt.Println("getblock     Extract a block from blockchain.db")
+	fmt.Println("retry        Run last block again to ensure it produces same result")
+	fmt.Println("state-stats  Print key counts and sizes of the state, grouped by bucket")
+	fmt.Println("fsck         Verify that all bucket indexes match the stored data")
+	fmt.Println("errors       List all error codes (list -format json) or generate constants (gen -lang go|ts)")
+	fmt.Println("version      Print the app version")
 	fmt.Println(`
   -home string
         directory to store files under (default "$HOME/.bns")`)
@@ -66,6 +69,12 @@ func main() {
 		err = server.GetBlockCmd(logger, *varHome, rest)
 	case "retry":
 		err = server.RetryCmd(app.InlineApp, logger, *varHome, rest)
+	case "state-stats":
+		err = server.StateStatsCmd(filepath.Join(*varHome, "bns.db"), rest)
+	case "fsck":
+		err = server.FsckCmd(app.QueryRouter(), filepath.Join(*varHome, "bns.db"), rest)
+	case "errors":
+		err = commands.ErrorsCmd(rest)
 	case "testgen":
 		err = commands.TestGenCmd(app.Examples(), rest)
 	case "version":
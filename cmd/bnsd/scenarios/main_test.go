@@ -170,6 +170,21 @@ func initGenesis(filename string, addr weave.Address) (*tm.GenesisDoc, error) {
 				"name":     "Main token of this chain",
 				"sig_figs": 6,
 			},
+			dict{
+				"ticker":   "CASH",
+				"name":     "Cash token",
+				"sig_figs": 9,
+			},
+			dict{
+				"ticker":   "ALX",
+				"name":     "Alx token",
+				"sig_figs": 9,
+			},
+			dict{
+				"ticker":   "PAJA",
+				"name":     "Paja token",
+				"sig_figs": 9,
+			},
 		},
 		"update_validators": dict{
 			"addresses": []weave.Address{
@@ -29,7 +29,9 @@ func TestSendTokensWithoutFee(t *testing.T) {
 
 		seq, err := aNonce.Next()
 		require.NoError(t, err)
-		tx := client.BuildSendTx(alice.PublicKey().Address(), emilia.PublicKey().Address(), coin, "test tx without fee")
+		// only pays a fee if the chain requires one
+		tx, err := bnsClient.NewSendTx(alice.PublicKey().Address(), emilia.PublicKey().Address(), coin, "test tx without fee")
+		require.NoError(t, err)
 		require.NoError(t, client.SignTx(tx, alice, chainID, seq))
 		resp := bnsClient.BroadcastTx(tx)
 		require.NoError(t, resp.IsError())
@@ -66,7 +68,7 @@ func TestSendTokenWithFee(t *testing.T) {
 			Payer: alice.PublicKey().Address(),
 			Fees: &x.Coin{
 				Ticker:     coin.Ticker,
-				Fractional: 1,
+				Fractional: 1000, // IOV has 6 significant figures
 				Whole:      0,
 			},
 		}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/iov-one/weave/orm/ormext"
+)
+
+// generate returns a file for every requested proto file
+// containing at least one message with bucket options
+func generate(req *plugin.CodeGeneratorRequest) ([]*plugin.CodeGeneratorResponse_File, error) {
+	wanted := make(map[string]bool)
+	for _, name := range req.FileToGenerate {
+		wanted[name] = true
+	}
+
+	var res []*plugin.CodeGeneratorResponse_File
+	for _, file := range req.ProtoFile {
+		if !wanted[file.GetName()] {
+			continue
+		}
+		buckets, err := fileBuckets(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file.GetName(), err)
+		}
+		if len(buckets) == 0 {
+			continue
+		}
+		content, err := render(ormFile{
+			Source:  file.GetName(),
+			Package: goPackageName(file),
+			Buckets: buckets,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file.GetName(), err)
+		}
+		res = append(res, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(strings.TrimSuffix(file.GetName(), ".proto") + ".orm.go"),
+			Content: proto.String(content),
+		})
+	}
+	return res, nil
+}
+
+// ormFile is the content of one generated file
+type ormFile struct {
+	Source  string
+	Package string
+	Buckets []bucket
+}
+
+// bucket describes the wrapper generated for a single message
+type bucket struct {
+	Type     string
+	Name     string
+	Sequence string
+	Indexes  []index
+}
+
+// index describes a secondary index and its indexer function
+type index struct {
+	Name   string
+	Unique bool
+	// Func is the name of the generated indexer
+	Func string
+	// Kind is one of "single", "multi" or "compound"
+	Kind string
+	// Keys are the Go expressions of the indexed values
+	Keys []string
+	// Field is the name of the repeated Go field of a multi key index
+	Field string
+	// Convert is true if the values of a multi key index are strings
+	Convert bool
+}
+
+// With returns the orm.Bucket method registering the index
+func (i index) With() string {
+	switch i.Kind {
+	case "multi":
+		return "WithMultiKeyIndex"
+	case "compound":
+		return "WithCompoundIndex"
+	default:
+		return "WithIndex"
+	}
+}
+
+// fileBuckets returns all top-level messages with bucket options
+func fileBuckets(file *descriptor.FileDescriptorProto) ([]bucket, error) {
+	var res []bucket
+	for _, msg := range file.MessageType {
+		if msg.Options == nil || !proto.HasExtension(msg.Options, ormext.E_Bucket) {
+			continue
+		}
+		ext, err := proto.GetExtension(msg.Options, ormext.E_Bucket)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", msg.GetName(), err)
+		}
+		b, err := newBucket(msg, ext.(*ormext.BucketOptions))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", msg.GetName(), err)
+		}
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+func newBucket(msg *descriptor.DescriptorProto, opts *ormext.BucketOptions) (bucket, error) {
+	if opts.Name == "" {
+		return bucket{}, fmt.Errorf("missing bucket name")
+	}
+	typ := generator.CamelCase(msg.GetName())
+	b := bucket{
+		Type:     typ,
+		Name:     opts.Name,
+		Sequence: opts.Sequence,
+	}
+	seen := make(map[string]bool)
+	for _, opt := range opts.Indexes {
+		if opt.Name == "" {
+			return bucket{}, fmt.Errorf("missing index name")
+		}
+		if seen[opt.Name] {
+			return bucket{}, fmt.Errorf("index %s declared twice", opt.Name)
+		}
+		seen[opt.Name] = true
+		idx, err := newIndex(typ, msg, opt)
+		if err != nil {
+			return bucket{}, fmt.Errorf("index %s: %s", opt.Name, err)
+		}
+		b.Indexes = append(b.Indexes, idx)
+	}
+	return b, nil
+}
+
+func newIndex(typ string, msg *descriptor.DescriptorProto, opts *ormext.IndexOptions) (index, error) {
+	idx := index{
+		Name:   opts.Name,
+		Unique: opts.Unique,
+		Func:   "idx" + typ + generator.CamelCase(opts.Name),
+	}
+	if len(opts.Fields) == 0 {
+		return index{}, fmt.Errorf("no fields")
+	}
+	for _, name := range opts.Fields {
+		field := findField(msg, name)
+		if field == nil {
+			return index{}, fmt.Errorf("unknown field %s", name)
+		}
+		isString := field.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING
+		if !isString && field.GetType() != descriptor.FieldDescriptorProto_TYPE_BYTES {
+			return index{}, fmt.Errorf("field %s must be bytes or string", name)
+		}
+		goName := generator.CamelCase(field.GetName())
+		if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			if len(opts.Fields) != 1 {
+				return index{}, fmt.Errorf("repeated field %s cannot be part of a compound index", name)
+			}
+			idx.Kind = "multi"
+			idx.Field = goName
+			idx.Convert = isString
+			return idx, nil
+		}
+		key := "m." + goName
+		if isString {
+			key = "[]byte(" + key + ")"
+		}
+		idx.Keys = append(idx.Keys, key)
+	}
+	idx.Kind = "single"
+	if len(idx.Keys) > 1 {
+		idx.Kind = "compound"
+	}
+	return idx, nil
+}
+
+func findField(msg *descriptor.DescriptorProto, name string) *descriptor.FieldDescriptorProto {
+	for _, f := range msg.Field {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// goPackageName returns the name of the package the gogoproto
+// generated code lives in
+func goPackageName(file *descriptor.FileDescriptorProto) string {
+	if pkg := file.GetOptions().GetGoPackage(); pkg != "" {
+		if i := strings.LastIndex(pkg, ";"); i >= 0 {
+			return pkg[i+1:]
+		}
+		return path.Base(pkg)
+	}
+	pkg := file.GetPackage()
+	if i := strings.LastIndex(pkg, "."); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return pkg
+}
+
+func render(f ormFile) (string, error) {
+	var buf bytes.Buffer
+	if err := ormTemplate.Execute(&buf, f); err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("invalid generated code: %s", err)
+	}
+	return string(src), nil
+}
+
+var ormTemplate = template.Must(template.New("orm").Parse(`// Code generated by protoc-gen-weaveorm. DO NOT EDIT.
+// source: {{.Source}}
+
+package {{.Package}}
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/orm"
+)
+{{range .Buckets}}{{$b := .}}
+// {{.Type}}Bucket is a type-safe wrapper around orm.Bucket
+// storing {{.Type}} objects
+type {{.Type}}Bucket struct {
+	orm.Bucket
+{{- if .Sequence}}
+	idSeq orm.Sequence
+{{- end}}
+}
+
+// New{{.Type}}Bucket initializes a {{.Type}}Bucket with all indexes
+func New{{.Type}}Bucket() {{.Type}}Bucket {
+	bucket := orm.NewBucket({{printf "%q" .Name}},
+		orm.NewSimpleObj(nil, new({{.Type}}))){{range .Indexes}}.
+		{{.With}}({{printf "%q" .Name}}, {{.Func}}, {{.Unique}}){{end}}
+	return {{.Type}}Bucket{
+		Bucket: bucket,
+{{- if .Sequence}}
+		idSeq: bucket.Sequence({{printf "%q" .Sequence}}),
+{{- end}}
+	}
+}
+
+// Save enforces the proper type
+func (b {{.Type}}Bucket) Save(db weave.KVStore, obj orm.Object) error {
+	if _, ok := obj.Value().(*{{.Type}}); !ok {
+		return orm.ErrInvalidObject(obj.Value())
+	}
+	return b.Bucket.Save(db, obj)
+}
+
+// Get{{.Type}} loads the {{.Type}} stored under the key,
+// it returns nil if there is none
+func (b {{.Type}}Bucket) Get{{.Type}}(db weave.ReadOnlyKVStore, key []byte) (*{{.Type}}, error) {
+	obj, err := b.Get(db, key)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	return As{{.Type}}(obj)
+}
+{{if .Sequence}}
+// Build assigns a key from the sequence to the {{.Type}} and returns
+// it as an orm Object. It does not persist the object in the store.
+func (b {{.Type}}Bucket) Build(db weave.KVStore, m *{{.Type}}) orm.Object {
+	return orm.NewSimpleObj(b.idSeq.NextVal(db), m)
+}
+{{end}}
+// As{{.Type}} extracts a {{.Type}} from an orm.Object. It returns nil
+// for a nil object and an error if the object stores any other type.
+func As{{.Type}}(obj orm.Object) (*{{.Type}}, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	m, ok := obj.Value().(*{{.Type}})
+	if !ok {
+		return nil, orm.ErrInvalidObject(obj.Value())
+	}
+	return m, nil
+}
+
+// Copy returns a deep copy, as required by orm.CloneableData
+func (m *{{.Type}}) Copy() orm.CloneableData {
+	return proto.Clone(m).(*{{.Type}})
+}
+{{range .Indexes}}
+{{- if eq .Kind "single"}}
+func {{.Func}}(obj orm.Object) ([]byte, error) {
+	m, err := As{{$b.Type}}(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+	return {{index .Keys 0}}, nil
+}
+{{else}}
+func {{.Func}}(obj orm.Object) ([][]byte, error) {
+	m, err := As{{$b.Type}}(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+{{- if eq .Kind "multi"}}
+	keys := make([][]byte, len(m.{{.Field}}))
+	for i, v := range m.{{.Field}} {
+		keys[i] = {{if .Convert}}[]byte(v){{else}}v{{end}}
+	}
+	return keys, nil
+{{- else}}
+	return [][]byte{ {{- range $i, $k := .Keys}}{{if $i}}, {{end}}{{$k}}{{end -}} }, nil
+{{- end}}
+}
+{{end}}
+{{- end}}
+{{- end}}`))
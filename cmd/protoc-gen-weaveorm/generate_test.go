@@ -0,0 +1,147 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/iov-one/weave/cmd/protoc-gen-weaveorm/internal/deal"
+	"github.com/iov-one/weave/orm/ormext"
+)
+
+var update = flag.Bool("update", false, "write the generated code to the fixture package")
+
+func field(name string, typ descriptor.FieldDescriptorProto_Type, repeated bool) *descriptor.FieldDescriptorProto {
+	label := descriptor.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptor.FieldDescriptorProto_LABEL_REPEATED
+	}
+	return &descriptor.FieldDescriptorProto{
+		Name:  proto.String(name),
+		Type:  typ.Enum(),
+		Label: label.Enum(),
+	}
+}
+
+func message(t *testing.T, name string, opts *ormext.BucketOptions) *descriptor.DescriptorProto {
+	t.Helper()
+	msg := &descriptor.DescriptorProto{
+		Name: proto.String(name),
+		Field: []*descriptor.FieldDescriptorProto{
+			field("sender", descriptor.FieldDescriptorProto_TYPE_BYTES, false),
+			field("chain_id", descriptor.FieldDescriptorProto_TYPE_STRING, false),
+			field("tags", descriptor.FieldDescriptorProto_TYPE_STRING, true),
+			field("timeout", descriptor.FieldDescriptorProto_TYPE_INT64, false),
+		},
+	}
+	if opts != nil {
+		msg.Options = &descriptor.MessageOptions{}
+		if err := proto.SetExtension(msg.Options, ormext.E_Bucket, opts); err != nil {
+			t.Fatalf("cannot set options: %s", err)
+		}
+	}
+	return msg
+}
+
+// request serializes and parses the request, as protoc passes it
+func request(t *testing.T, file *descriptor.FileDescriptorProto) *plugin.CodeGeneratorRequest {
+	t.Helper()
+	req := &plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile:      []*descriptor.FileDescriptorProto{file},
+	}
+	raw, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("cannot serialize request: %s", err)
+	}
+	var res plugin.CodeGeneratorRequest
+	if err := proto.Unmarshal(raw, &res); err != nil {
+		t.Fatalf("cannot parse request: %s", err)
+	}
+	return &res
+}
+
+// TestGenerate checks the code generated for the messages of the
+// internal/deal package, which is compiled with the rest of the code.
+// Run the tests with -update after changing the template.
+func TestGenerate(t *testing.T) {
+	file, _ := descriptor.ForMessage(new(deal.Deal))
+	files, err := generate(request(t, file))
+	if err != nil {
+		t.Fatalf("cannot generate: %s", err)
+	}
+	// messages without options are skipped, so there is one file
+	if len(files) != 1 {
+		t.Fatalf("want one file, got %d", len(files))
+	}
+	const golden = "cmd/protoc-gen-weaveorm/internal/deal/codec.orm.go"
+	if name := files[0].GetName(); name != golden {
+		t.Fatalf("unexpected file name: %s", name)
+	}
+	src := files[0].GetContent()
+
+	path := "internal/deal/codec.orm.go"
+	if *update {
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatalf("cannot update %s: %s", path, err)
+		}
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("cannot read %s: %s", path, err)
+	}
+	if string(want) != src {
+		t.Fatalf("%s is outdated, run the tests with -update. Generated:\n%s", path, src)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	cases := map[string]*ormext.BucketOptions{
+		"missing name":  {},
+		"unknown field": {Name: "deal", Indexes: []*ormext.IndexOptions{{Name: "x", Fields: []string{"nope"}}}},
+		"int field":     {Name: "deal", Indexes: []*ormext.IndexOptions{{Name: "x", Fields: []string{"timeout"}}}},
+		"no fields":     {Name: "deal", Indexes: []*ormext.IndexOptions{{Name: "x"}}},
+		"duplicate": {Name: "deal", Indexes: []*ormext.IndexOptions{
+			{Name: "x", Fields: []string{"sender"}},
+			{Name: "x", Fields: []string{"chain_id"}},
+		}},
+		"repeated compound": {Name: "deal", Indexes: []*ormext.IndexOptions{
+			{Name: "x", Fields: []string{"sender", "tags"}},
+		}},
+	}
+	for name, opts := range cases {
+		t.Run(name, func(t *testing.T) {
+			file := &descriptor.FileDescriptorProto{
+				Name:        proto.String("x/deal/codec.proto"),
+				Package:     proto.String("deal"),
+				MessageType: []*descriptor.DescriptorProto{message(t, "Deal", opts)},
+			}
+			if _, err := generate(request(t, file)); err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+}
+
+func TestGoPackageName(t *testing.T) {
+	cases := map[string]*descriptor.FileDescriptorProto{
+		"escrow": {Package: proto.String("escrow")},
+		"nested": {Package: proto.String("weave.nested")},
+		"gopkg": {
+			Package: proto.String("ignored"),
+			Options: &descriptor.FileOptions{GoPackage: proto.String("github.com/iov-one/weave/x/gopkg")},
+		},
+		"alias": {
+			Package: proto.String("ignored"),
+			Options: &descriptor.FileOptions{GoPackage: proto.String("github.com/iov-one/weave/x/foo;alias")},
+		},
+	}
+	for want, file := range cases {
+		if got := goPackageName(file); got != want {
+			t.Errorf("want %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-weaveorm. DO NOT EDIT.
+// source: cmd/protoc-gen-weaveorm/internal/deal/codec.proto
+
+package deal
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/orm"
+)
+
+// DealBucket is a type-safe wrapper around orm.Bucket
+// storing Deal objects
+type DealBucket struct {
+	orm.Bucket
+	idSeq orm.Sequence
+}
+
+// NewDealBucket initializes a DealBucket with all indexes
+func NewDealBucket() DealBucket {
+	bucket := orm.NewBucket("deal",
+		orm.NewSimpleObj(nil, new(Deal))).
+		WithIndex("sender", idxDealSender, false).
+		WithIndex("chain", idxDealChain, true).
+		WithMultiKeyIndex("tags", idxDealTags, false).
+		WithCompoundIndex("sender_chain", idxDealSenderChain, false)
+	return DealBucket{
+		Bucket: bucket,
+		idSeq:  bucket.Sequence("id"),
+	}
+}
+
+// Save enforces the proper type
+func (b DealBucket) Save(db weave.KVStore, obj orm.Object) error {
+	if _, ok := obj.Value().(*Deal); !ok {
+		return orm.ErrInvalidObject(obj.Value())
+	}
+	return b.Bucket.Save(db, obj)
+}
+
+// GetDeal loads the Deal stored under the key,
+// it returns nil if there is none
+func (b DealBucket) GetDeal(db weave.ReadOnlyKVStore, key []byte) (*Deal, error) {
+	obj, err := b.Get(db, key)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	return AsDeal(obj)
+}
+
+// Build assigns a key from the sequence to the Deal and returns
+// it as an orm Object. It does not persist the object in the store.
+func (b DealBucket) Build(db weave.KVStore, m *Deal) orm.Object {
+	return orm.NewSimpleObj(b.idSeq.NextVal(db), m)
+}
+
+// AsDeal extracts a Deal from an orm.Object. It returns nil
+// for a nil object and an error if the object stores any other type.
+func AsDeal(obj orm.Object) (*Deal, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	m, ok := obj.Value().(*Deal)
+	if !ok {
+		return nil, orm.ErrInvalidObject(obj.Value())
+	}
+	return m, nil
+}
+
+// Copy returns a deep copy, as required by orm.CloneableData
+func (m *Deal) Copy() orm.CloneableData {
+	return proto.Clone(m).(*Deal)
+}
+
+func idxDealSender(obj orm.Object) ([]byte, error) {
+	m, err := AsDeal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+	return m.Sender, nil
+}
+
+func idxDealChain(obj orm.Object) ([]byte, error) {
+	m, err := AsDeal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+	return []byte(m.ChainId), nil
+}
+
+func idxDealTags(obj orm.Object) ([][]byte, error) {
+	m, err := AsDeal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+	keys := make([][]byte, len(m.Tags))
+	for i, v := range m.Tags {
+		keys[i] = []byte(v)
+	}
+	return keys, nil
+}
+
+func idxDealSenderChain(obj orm.Object) ([][]byte, error) {
+	m, err := AsDeal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, orm.ErrInvalidIndex("nil")
+	}
+	return [][]byte{m.Sender, []byte(m.ChainId)}, nil
+}
@@ -0,0 +1,11 @@
+package deal
+
+import "github.com/iov-one/weave/errors"
+
+// Validate ensures the deal has a sender, as required by orm.CloneableData
+func (m *Deal) Validate() error {
+	if len(m.Sender) == 0 {
+		return errors.InvalidModelErr.New("missing sender")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package deal
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDealBucket(t *testing.T) {
+	db := store.MemStore()
+	bucket := NewDealBucket()
+
+	d := &Deal{Sender: []byte("alice"), ChainId: "test", Tags: []string{"a", "b"}}
+	obj := bucket.Build(db, d)
+	require.NoError(t, bucket.Save(db, obj))
+	// other types are refused
+	assert.Error(t, bucket.Save(db, orm.NewSimpleObj([]byte("x"), new(orm.Counter))))
+
+	loaded, err := bucket.GetDeal(db, obj.Key())
+	require.NoError(t, err)
+	assert.Equal(t, d, loaded)
+
+	queries := map[string][]byte{
+		"sender":       []byte("alice"),
+		"chain":        []byte("test"),
+		"tags":         []byte("b"),
+		"sender_chain": orm.CompoundKey([]byte("alice"), []byte("test")),
+	}
+	for name, key := range queries {
+		objs, err := bucket.GetIndexed(db, name, key)
+		require.NoError(t, err, name)
+		assert.Len(t, objs, 1, name)
+	}
+
+	// the copy is independent from the original
+	cp := d.Copy().(*Deal)
+	cp.Tags[0] = "c"
+	assert.Equal(t, "a", d.Tags[0])
+}
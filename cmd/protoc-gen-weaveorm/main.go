@@ -0,0 +1,76 @@
+/*
+protoc-gen-weaveorm is a protoc plugin generating type-safe orm
+buckets for all messages with the (ormext.bucket) option set.
+
+	import "github.com/iov-one/weave/orm/ormext/ormext.proto";
+
+	message Escrow {
+	  option (ormext.bucket) = {
+	    name: "esc"
+	    sequence: "id"
+	    indexes: {name: "sender", fields: "sender"}
+	    indexes: {name: "sender_recipient", fields: ["sender", "recipient"]}
+	  };
+	  bytes sender = 1;
+	  bytes recipient = 2;
+	}
+
+For each such message, it writes to <file>.orm.go, next to the
+gogoproto generated code:
+
+	EscrowBucket        wrapper around orm.Bucket, with all indexes
+	NewEscrowBucket()   its constructor
+	Save, GetEscrow     methods enforcing the stored type
+	Build               assigns a key from the sequence (if any)
+	AsEscrow            checked cast from an orm.Object
+	(*Escrow).Copy      deep copy, as required by orm.CloneableData
+
+Validate is not generated and must be written by hand.
+
+Usage:
+
+	protoc --gogofaster_out=. --weaveorm_out=. x/escrow/*.proto
+*/
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "protoc-gen-weaveorm: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("cannot read request: %s", err)
+	}
+	var req plugin.CodeGeneratorRequest
+	if err := proto.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("cannot parse request: %s", err)
+	}
+
+	var res plugin.CodeGeneratorResponse
+	files, err := generate(&req)
+	if err != nil {
+		// protoc reports errors returned in the response
+		res.Error = proto.String(err.Error())
+	}
+	res.File = files
+
+	out, err := proto.Marshal(&res)
+	if err != nil {
+		return fmt.Errorf("cannot serialize response: %s", err)
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -17,6 +16,7 @@ import (
 	"github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/validators"
 )
 
@@ -240,7 +240,7 @@ content.
 	}
 	var (
 		tmAddrFl = fl.String("tm", "https://bns.NETWORK.iov.one:443", "Tendermint node address. Use proper NETWORK name.")
-		keyFl    = fl.String("key", "", "Hex encoded, private key that transaction should be signed with.")
+		keyFl    = fl.String("key", "", "Hex encoded, ed25519 (64 bytes) or secp256k1 (32 bytes) private key that transaction should be signed with.")
 	)
 	fl.Parse(args)
 
@@ -336,8 +336,9 @@ func cmdAdd(
 	var (
 		tmAddrFl = fl.String("tm", "https://bns.NETWORK.iov.one:443", "Tendermint node address. Use proper NETWORK name.")
 		pubKeyFl = fl.String("pubkey", "", "Base64 encoded, ed25519 public key.")
-		hexKeyFl = fl.String("key", "", "Hex encoded, private key of the validator that is to be added/updated.")
+		hexKeyFl = fl.String("key", "", "Hex encoded, ed25519 (64 bytes) or secp256k1 (32 bytes) private key of the validator that is to be added/updated.")
 		powerFl  = fl.Int64("power", 10, "Validator node power. Set to 0 to delete a node.")
+		feeFl    = fl.String("fee", "", `Transaction fee paid by the key owner, eg. "0.01 IOV". Defaults to the minimal fee of the network.`)
 	)
 	fl.Parse(args)
 
@@ -374,7 +375,18 @@ func cmdAdd(
 		},
 	)
 
-	aNonce := client.NewNonce(bnsClient, key.PublicKey().Address())
+	payer := key.PublicKey().Address()
+	if *feeFl != "" {
+		fee, err := bnsClient.ParseCoin(*feeFl)
+		if err != nil {
+			return fmt.Errorf("invalid fee: %s", err)
+		}
+		addValidatorTx.Fees = &cash.FeeInfo{Payer: payer, Fees: &fee}
+	} else if err := bnsClient.PrefillFees(addValidatorTx, payer); err != nil {
+		return fmt.Errorf("cannot get the minimal fee: %s", err)
+	}
+
+	aNonce := client.NewNonce(bnsClient, payer)
 	if seq, err := aNonce.Next(); err != nil {
 		return fmt.Errorf("cannot get the next sequence number: %s", err)
 	} else {
@@ -388,15 +400,9 @@ func cmdAdd(
 }
 
 func decodePrivateKey(hexSeed string) (*crypto.PrivateKey, error) {
-	data, err := hex.DecodeString(hexSeed)
+	key, err := client.DecodePrivateKeyFromSeed(hexSeed)
 	if err != nil {
-		return nil, fmt.Errorf("cannot hex decode: %s", err)
-	}
-	if len(data) != 64 {
-		return nil, errors.New("invalid key length")
-	}
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
+		return nil, fmt.Errorf("cannot decode: %s", err)
 	}
 	return key, nil
 }
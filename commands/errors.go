@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// ErrorsCmd describes all errors registered by the application,
+// so client SDKs can map the ABCI codes it returns.
+//
+//	errors list [-format text|json]
+//	    prints all codes, their description and owning extension
+//	errors gen -lang go|ts [-package name] [-out file]
+//	    generates a file with a constant for every code
+func ErrorsCmd(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing errors command, use list or gen")
+	}
+	switch args[0] {
+	case "list":
+		return errorsList(os.Stdout, args[1:])
+	case "gen":
+		return errorsGen(os.Stdout, args[1:])
+	default:
+		return fmt.Errorf("unknown errors command: %s", args[0])
+	}
+}
+
+func errorsList(out io.Writer, args []string) error {
+	var format string
+	listFlags := flag.NewFlagSet("errors list", flag.ExitOnError)
+	listFlags.StringVar(&format, "format", "text", "output format: text or json")
+	if err := listFlags.Parse(args); err != nil {
+		return err
+	}
+
+	regs := errors.Registered()
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(regs)
+	case "text":
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "CODE\tEXTENSION\tDESCRIPTION")
+		for _, r := range regs {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", r.Code, r.Extension, r.Description)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func errorsGen(out io.Writer, args []string) error {
+	var lang, pkg, path string
+	genFlags := flag.NewFlagSet("errors gen", flag.ExitOnError)
+	genFlags.StringVar(&lang, "lang", "go", "language of the generated file: go or ts")
+	genFlags.StringVar(&pkg, "package", "errcodes", "package name of the generated go file")
+	genFlags.StringVar(&path, "out", "", "file to write to (default stdout)")
+	if err := genFlags.Parse(args); err != nil {
+		return err
+	}
+
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	consts := errorConstants(errors.Registered())
+	switch lang {
+	case "go":
+		return writeGoErrors(out, pkg, consts)
+	case "ts":
+		return writeTSErrors(out, consts)
+	default:
+		return fmt.Errorf("unknown language: %s", lang)
+	}
+}
+
+// errorConstant is a registered error with the name of its constant
+type errorConstant struct {
+	errors.Registration
+	// Name is CamelCase, eg. EscrowNoEscrow
+	Name string
+}
+
+// errorConstants names all registered errors after their extension
+// and description. Duplicate names get the code appended.
+func errorConstants(regs []errors.Registration) []errorConstant {
+	res := make([]errorConstant, len(regs))
+	seen := make(map[string]bool)
+	for i, r := range regs {
+		name := camelCase(r.Extension + " " + r.Description)
+		if name == "" || seen[name] {
+			name = fmt.Sprintf("%sCode%d", name, r.Code)
+		}
+		seen[name] = true
+		res[i] = errorConstant{Registration: r, Name: name}
+	}
+	return res
+}
+
+// camelCase joins all words of s, capitalized, dropping any other
+// characters, eg. "escrow no escrow" becomes EscrowNoEscrow
+func camelCase(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, "")
+}
+
+// screamingCase converts a CamelCase name to SCREAMING_SNAKE_CASE
+func screamingCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+const generatedHeader = "// Code generated by \"errors gen\". DO NOT EDIT.\n"
+
+func writeGoErrors(out io.Writer, pkg string, consts []errorConstant) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n// Package %s lists the ABCI codes of all registered errors.\npackage %s\n\n", generatedHeader, pkg, pkg)
+	b.WriteString("const (\n")
+	for _, c := range consts {
+		fmt.Fprintf(&b, "\t// %s is %q (%s)\n\t%s uint32 = %d\n", c.Name, c.Description, c.Extension, c.Name, c.Code)
+	}
+	b.WriteString(")\n\n// Descriptions maps every code to the description it was registered with.\n")
+	b.WriteString("var Descriptions = map[uint32]string{\n")
+	for _, c := range consts {
+		fmt.Fprintf(&b, "\t%s: %q,\n", c.Name, c.Description)
+	}
+	b.WriteString("}\n")
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(src)
+	return err
+}
+
+func writeTSErrors(out io.Writer, consts []errorConstant) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", generatedHeader)
+	for _, c := range consts {
+		fmt.Fprintf(&b, "// %s (%s)\nexport const %s = %d;\n", c.Description, c.Extension, screamingCase(c.Name), c.Code)
+	}
+	b.WriteString("\nexport const errorDescriptions: { readonly [code: number]: string } = {\n")
+	for _, c := range consts {
+		fmt.Fprintf(&b, "  [%s]: %q,\n", screamingCase(c.Name), c.Description)
+	}
+	b.WriteString("};\n")
+	_, err := io.WriteString(out, b.String())
+	return err
+}
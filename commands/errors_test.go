@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+)
+
+func TestErrorsList(t *testing.T) {
+	var out bytes.Buffer
+	if err := errorsList(&out, []string{"-format", "json"}); err != nil {
+		t.Fatalf("cannot list: %s", err)
+	}
+	var regs []errors.Registration
+	if err := json.Unmarshal(out.Bytes(), &regs); err != nil {
+		t.Fatalf("cannot decode: %s", err)
+	}
+	want := errors.Registration{Code: 2, Description: "unauthorized", Extension: "weave"}
+	if len(regs) < 2 || regs[1] != want {
+		t.Fatalf("want %+v, got %+v", want, regs)
+	}
+}
+
+func TestErrorConstants(t *testing.T) {
+	consts := errorConstants([]errors.Registration{
+		{Code: 1010, Description: "no escrow", Extension: "escrow"},
+		{Code: 1011, Description: "no-escrow", Extension: "escrow"},
+		{Code: 111222, Description: "panic", Extension: ""},
+	})
+	names := []string{"EscrowNoEscrow", "EscrowNoEscrowCode1011", "Panic"}
+	for i, c := range consts {
+		if c.Name != names[i] {
+			t.Errorf("want %s, got %s", names[i], c.Name)
+		}
+	}
+
+	var goOut, tsOut bytes.Buffer
+	if err := writeGoErrors(&goOut, "codes", consts); err != nil {
+		t.Fatalf("cannot generate go: %s", err)
+	}
+	if !strings.Contains(goOut.String(), "EscrowNoEscrow uint32 = 1010\n") {
+		t.Errorf("unexpected go output:\n%s", goOut.String())
+	}
+	if err := writeTSErrors(&tsOut, consts); err != nil {
+		t.Fatalf("cannot generate ts: %s", err)
+	}
+	if !strings.Contains(tsOut.String(), "export const ESCROW_NO_ESCROW_CODE1011 = 1011;\n") {
+		t.Errorf("unexpected ts output:\n%s", tsOut.String())
+	}
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/store/changelog"
+)
+
+const (
+	// changelogFile is created in the home directory, next to the database
+	changelogFile = "changelog.jsonl"
+	// configChangelog is the setting of config.toml enabling the changelog,
+	// eg. `weave_changelog = true`
+	configChangelog = "weave_changelog"
+)
+
+// commitHooker is implemented by all applications built on app.StoreApp
+type commitHooker interface {
+	abci.Application
+	WithCommitHook(app.CommitHook) *app.StoreApp
+}
+
+// changelogEnabled reads the changelog setting from the config.toml
+// file in the home directory. It is disabled if there is no file.
+func changelogEnabled(home string) (bool, error) {
+	f, err := os.Open(filepath.Join(home, DirConfig, "config.toml"))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scan := bufio.NewScanner(f)
+	for scan.Scan() {
+		kv := strings.SplitN(scan.Text(), "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) != configChangelog {
+			continue
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return false, fmt.Errorf("invalid %s setting: %s", configChangelog, err)
+		}
+		return enabled, nil
+	}
+	return false, scan.Err()
+}
+
+// enableChangelog registers a commit hook on the application that
+// appends the changes of every block to the changelog file.
+// It fails if the file does not end at the committed height.
+// It returns the path of the file.
+func enableChangelog(application abci.Application, home string) (string, error) {
+	hooked, ok := application.(commitHooker)
+	if !ok {
+		return "", fmt.Errorf("application %T does not support commit hooks", application)
+	}
+	path := filepath.Join(home, changelogFile)
+	height := hooked.Info(abci.RequestInfo{}).LastBlockHeight
+	w, err := changelog.NewWriter(path, height)
+	if err != nil {
+		return "", err
+	}
+	hooked.WithCommitHook(w.Append)
+	return path, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestChangelogEnabled(t *testing.T) {
+	home, err := ioutil.TempDir("", "changelog")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %s", err)
+	}
+	defer os.RemoveAll(home)
+
+	// no config, as when tendermint uses another home
+	if enabled, err := changelogEnabled(home); err != nil || enabled {
+		t.Fatalf("want disabled, got %v (%v)", enabled, err)
+	}
+
+	cases := map[string]struct {
+		config  string
+		enabled bool
+		wantErr bool
+	}{
+		"not set":  {config: "proxy_app = \"tcp://127.0.0.1:26658\"\n"},
+		"enabled":  {config: "weave_changelog = true\nproxy_app = \"x\"\n", enabled: true},
+		"disabled": {config: "weave_changelog=false\n"},
+		"invalid":  {config: "weave_changelog = yes\n", wantErr: true},
+	}
+	if err := os.Mkdir(filepath.Join(home, DirConfig), 0755); err != nil {
+		t.Fatalf("cannot create config dir: %s", err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(home, DirConfig, "config.toml")
+			if err := ioutil.WriteFile(path, []byte(tc.config), 0644); err != nil {
+				t.Fatalf("cannot write config: %s", err)
+			}
+			enabled, err := changelogEnabled(home)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if enabled != tc.enabled {
+				t.Fatalf("want %v, got %v", tc.enabled, enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+func parseFsckArgs(args []string) (int, error) {
+	var height int
+	fsckFlags := flag.NewFlagSet("fsck", flag.ExitOnError)
+	fsckFlags.IntVar(&height, flagHeight, 0, "height of the state to check (default latest)")
+	err := fsckFlags.Parse(args)
+	return height, err
+}
+
+// FsckCmd verifies that the indexes of all buckets registered in the
+// query router match the primary data stored at dbPath. All differences
+// are printed and an error is returned if any was found.
+//
+// The database is never modified, as repairing the state of a single node
+// would make it diverge from the network. Use orm.Bucket.RepairIndexes
+// in a migration executed by every node instead.
+func FsckCmd(qr weave.QueryRouter, dbPath string, args []string) error {
+	height, err := parseFsckArgs(args)
+	if err != nil {
+		return err
+	}
+
+	tree, ver, err := readTree(dbPath, height)
+	if err != nil {
+		return fmt.Errorf("error reading abci data: %s", err)
+	}
+	db := store.ReadOnly(iavlstore.NewCommitStoreFromTree(tree).Adapter())
+
+	fmt.Printf("Checking state at height %d\n", ver)
+	var total int
+	for _, b := range orm.BucketsFromRouter(qr) {
+		mismatches, err := b.CheckIndexes(db)
+		if err != nil {
+			return fmt.Errorf("cannot check bucket %s: %s", b.Name(), err)
+		}
+		printMismatches(os.Stdout, b.Name(), mismatches)
+		total += len(mismatches)
+	}
+	if total > 0 {
+		return fmt.Errorf("found %d inconsistent index entries", total)
+	}
+	return nil
+}
+
+func printMismatches(w io.Writer, bucket string, mismatches []orm.IndexMismatch) {
+	if len(mismatches) == 0 {
+		fmt.Fprintf(w, "%s: ok\n", bucket)
+		return
+	}
+	fmt.Fprintf(w, "%s: %d inconsistent index entries\n", bucket, len(mismatches))
+	for _, m := range mismatches {
+		fmt.Fprintf(w, "  %s %X: want %X, got %X\n", m.Index, m.Key, m.Want, m.Got)
+	}
+}
@@ -32,6 +32,10 @@ func parseFlags(args []string) (string, bool, error) {
 type AppGenerator func(string, log.Logger, bool) (abci.Application, error)
 
 // StartCmd initializes the application, and
+//
+// When config.toml in the home directory sets `weave_changelog = true`,
+// the state changes of every block are appended to changelog.jsonl
+// in the home directory, see store/changelog.
 func StartCmd(gen AppGenerator, logger log.Logger, home string, args []string) error {
 	addr, debug, err := parseFlags(args)
 	if err != nil {
@@ -44,6 +48,18 @@ func StartCmd(gen AppGenerator, logger log.Logger, home string, args []string) e
 		return err
 	}
 
+	withChangelog, err := changelogEnabled(home)
+	if err != nil {
+		return err
+	}
+	if withChangelog {
+		path, err := enableChangelog(app, home)
+		if err != nil {
+			return err
+		}
+		logger.Info("Writing block changes", "changelog", path)
+	}
+
 	logger.Info("Starting ABCI app", "bind", addr)
 
 	svr, err := server.NewServer(addr, "socket", app)
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/iov-one/weave/store"
+)
+
+const (
+	flagTop = "top"
+)
+
+type stateStatsArgs struct {
+	height int
+	top    int
+}
+
+func parseStateStatsArgs(args []string) (stateStatsArgs, error) {
+	var res stateStatsArgs
+	statsFlags := flag.NewFlagSet("state-stats", flag.ExitOnError)
+	statsFlags.IntVar(&res.height, flagHeight, 0, "height of the state to inspect (default latest)")
+	statsFlags.IntVar(&res.top, flagTop, 3, "number of largest entries to show for each group")
+	err := statsFlags.Parse(args)
+	return res, err
+}
+
+// StateStatsCmd iterates over the committed state stored at dbPath
+// and prints the number of keys and their sizes, grouped by
+// the bucket, index or other subspace they belong to.
+func StateStatsCmd(dbPath string, args []string) error {
+	flags, err := parseStateStatsArgs(args)
+	if err != nil {
+		return err
+	}
+
+	tree, ver, err := readTree(dbPath, flags.height)
+	if err != nil {
+		return fmt.Errorf("error reading abci data: %s", err)
+	}
+
+	stats := newStateStats(flags.top)
+	tree.Iterate(func(key, value []byte) bool {
+		stats.add(key, value)
+		return false
+	})
+
+	fmt.Printf("State at height %d\n\n", ver)
+	return stats.write(os.Stdout)
+}
+
+// groupStats sums up all entries belonging to one group
+type groupStats struct {
+	name       string
+	kind       string
+	count      int
+	keyBytes   int
+	valueBytes int
+	largest    []entrySize
+}
+
+type entrySize struct {
+	key  []byte
+	size int
+}
+
+// stateStats collects statistics of all keys in the state
+type stateStats struct {
+	top    int
+	groups map[string]*groupStats
+}
+
+func newStateStats(top int) *stateStats {
+	return &stateStats{
+		top:    top,
+		groups: make(map[string]*groupStats),
+	}
+}
+
+// add accounts one key-value pair in the group it belongs to
+func (s *stateStats) add(key, value []byte) {
+	name := store.KeyPrefix(key)
+	g, ok := s.groups[name]
+	if !ok {
+		g = &groupStats{name: name, kind: groupKind(name)}
+		s.groups[name] = g
+	}
+	g.count++
+	g.keyBytes += len(key)
+	g.valueBytes += len(value)
+	g.track(entrySize{
+		key:  append([]byte(nil), key...),
+		size: len(key) + len(value),
+	}, s.top)
+}
+
+// track keeps the n largest entries of the group
+func (g *groupStats) track(e entrySize, n int) {
+	if n <= 0 {
+		return
+	}
+	if len(g.largest) == n && g.largest[n-1].size >= e.size {
+		return
+	}
+	g.largest = append(g.largest, e)
+	sort.SliceStable(g.largest, func(i, j int) bool {
+		return g.largest[i].size > g.largest[j].size
+	})
+	if len(g.largest) > n {
+		g.largest = g.largest[:n]
+	}
+}
+
+// groupKind describes the group based on the naming conventions
+// used by the orm, gconf and app packages. Applied orm migrations
+// are stored as "_migration:<name>".
+func groupKind(name string) string {
+	switch {
+	case name == "":
+		return "other"
+	case strings.HasPrefix(name, "_i."):
+		return "index"
+	case strings.HasPrefix(name, "_s."):
+		return "sequence"
+	case strings.HasPrefix(name, "_h."):
+		return "history"
+	case strings.HasPrefix(name, "_c."):
+		return "counter"
+	case name == "gconf":
+		return "config"
+	case name == "_wv", name == "_migration":
+		return "internal"
+	default:
+		return "bucket"
+	}
+}
+
+// sorted returns all groups, the biggest ones first
+func (s *stateStats) sorted() []*groupStats {
+	res := make([]*groupStats, 0, len(s.groups))
+	for _, g := range s.groups {
+		res = append(res, g)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		si := res[i].keyBytes + res[i].valueBytes
+		sj := res[j].keyBytes + res[j].valueBytes
+		if si != sj {
+			return si > sj
+		}
+		return res[i].name < res[j].name
+	})
+	return res
+}
+
+// write prints the summary as a table, followed by
+// the largest entries of each group
+func (s *stateStats) write(out io.Writer) error {
+	groups := s.sorted()
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP\tKIND\tCOUNT\tKEY BYTES\tVALUE BYTES")
+	var total groupStats
+	for _, g := range groups {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", displayName(g.name), g.kind, g.count, g.keyBytes, g.valueBytes)
+		total.count += g.count
+		total.keyBytes += g.keyBytes
+		total.valueBytes += g.valueBytes
+	}
+	fmt.Fprintf(w, "%s\t\t%d\t%d\t%d\n", "TOTAL", total.count, total.keyBytes, total.valueBytes)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if s.top <= 0 {
+		return nil
+	}
+	fmt.Fprintln(out, "\nLargest entries")
+	w = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP\tSIZE\tKEY")
+	for _, g := range groups {
+		for _, e := range g.largest {
+			fmt.Fprintf(w, "%s\t%d\t%X\n", displayName(g.name), e.size, e.key)
+		}
+	}
+	return w.Flush()
+}
+
+func displayName(name string) string {
+	if name == "" {
+		return "(no prefix)"
+	}
+	return name
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStateStats(t *testing.T) {
+	stats := newStateStats(2)
+	stats.add([]byte("cash:alice"), []byte("1234567890"))
+	stats.add([]byte("cash:bob"), []byte("12"))
+	stats.add([]byte("cash:carl"), []byte("12345"))
+	stats.add([]byte("_i.escrow_sender:alice"), []byte("ref"))
+	stats.add([]byte("_h.escrow:alice"), []byte("escrow"))
+	stats.add([]byte("_s.escrow:id"), []byte("12345678"))
+	stats.add([]byte("_c.escrow:"), []byte("12345678"))
+	stats.add([]byte("gconf:cash:minimal_fee"), []byte(`{"whole":1}`))
+	stats.add([]byte("_wv:chainID"), []byte("test-chain"))
+	stats.add([]byte("_migration:escrow_indexes"), []byte{1})
+	stats.add([]byte("nosep"), []byte("x"))
+
+	cash := stats.groups["cash"]
+	if cash == nil {
+		t.Fatal("cash group missing")
+	}
+	if cash.count != 3 || cash.keyBytes != 27 || cash.valueBytes != 17 {
+		t.Fatalf("unexpected cash stats: %+v", cash)
+	}
+	if len(cash.largest) != 2 || string(cash.largest[0].key) != "cash:alice" || string(cash.largest[1].key) != "cash:carl" {
+		t.Fatalf("unexpected largest entries: %+v", cash.largest)
+	}
+
+	kinds := map[string]string{
+		"cash":             "bucket",
+		"_i.escrow_sender": "index",
+		"_s.escrow":        "sequence",
+		"_h.escrow":        "history",
+		"_c.escrow":        "counter",
+		"gconf":            "config",
+		"_wv":              "internal",
+		"_migration":       "internal",
+		"":                 "other",
+	}
+	for name, kind := range kinds {
+		g, ok := stats.groups[name]
+		if !ok {
+			t.Fatalf("missing group %q", name)
+		}
+		if g.kind != kind {
+			t.Errorf("want %q kind to be %q, got %q", name, kind, g.kind)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := stats.write(&out); err != nil {
+		t.Fatalf("cannot write: %s", err)
+	}
+	if !strings.Contains(out.String(), "TOTAL") {
+		t.Fatalf("missing total: %s", out.String())
+	}
+}
@@ -0,0 +1,116 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave"
+)
+
+const (
+	// secp256k1 public keys are stored compressed
+	secp256k1PubKeySize  = 33
+	secp256k1PrivKeySize = 32
+	// signatures are stored as R || S, 32 bytes each
+	secp256k1SigSize = 64
+)
+
+// halfOrder is used to reject malleable signatures
+var halfOrder = new(big.Int).Rsh(btcec.S256().N, 1)
+
+var _ PubKey = (*PublicKey_Secp256K1)(nil)
+
+// Verify verifies the signature was created with this message and public key.
+// The message is hashed with sha256 before verification and only signatures
+// with a low S value are accepted.
+func (p *PublicKey_Secp256K1) Verify(message []byte, sig *Signature) bool {
+	secsig, ok := sig.GetSig().(*Signature_Secp256K1)
+	if !ok {
+		return false
+	}
+	// only allow one encoding per key, so it has only one address
+	if len(p.Secp256K1) != secp256k1PubKeySize {
+		return false
+	}
+	publicKey, err := btcec.ParsePubKey(p.Secp256K1, btcec.S256())
+	if err != nil {
+		return false
+	}
+
+	if len(secsig.Secp256K1) != secp256k1SigSize {
+		return false
+	}
+	s := &btcec.Signature{
+		R: new(big.Int).SetBytes(secsig.Secp256K1[:32]),
+		S: new(big.Int).SetBytes(secsig.Secp256K1[32:]),
+	}
+	if s.S.Cmp(halfOrder) > 0 {
+		return false
+	}
+	hash := sha256.Sum256(message)
+	return s.Verify(hash[:], publicKey)
+}
+
+// Condition encodes the public key into a weave permission
+func (p *PublicKey_Secp256K1) Condition() weave.Condition {
+	// the type is shortened to fit the condition format
+	return weave.NewCondition(ExtensionName, "secp256k", p.Secp256K1)
+}
+
+var _ Signer = (*PrivateKey_Secp256K1)(nil)
+
+// Sign returns a matching signature for this private key.
+// The message is hashed with sha256 before signing.
+func (p *PrivateKey_Secp256K1) Sign(message []byte) (*Signature, error) {
+	if len(p.Secp256K1) != secp256k1PrivKeySize {
+		return nil, errors.New("invalid private key length")
+	}
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	hash := sha256.Sum256(message)
+	s, err := privateKey.Sign(hash[:])
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, secp256k1SigSize)
+	r, sb := s.R.Bytes(), s.S.Bytes()
+	copy(bz[32-len(r):32], r)
+	copy(bz[64-len(sb):], sb)
+	sig := &Signature{
+		Sig: &Signature_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+	return sig, nil
+}
+
+// PublicKey returns the corresponding PublicKey,
+// or nil if the private key is invalid
+func (p *PrivateKey_Secp256K1) PublicKey() *PublicKey {
+	if len(p.Secp256K1) != secp256k1PrivKeySize {
+		return nil
+	}
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	return &PublicKey{
+		Pub: &PublicKey_Secp256K1{
+			Secp256K1: pub.SerializeCompressed(),
+		},
+	}
+}
+
+// GenPrivKeySecp256k1 returns a random new private key
+func GenPrivKeySecp256k1() *PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+	bz := make([]byte, secp256k1PrivKeySize)
+	d := priv.D.Bytes()
+	copy(bz[secp256k1PrivKeySize-len(d):], d)
+	return &PrivateKey{
+		Priv: &PrivateKey_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecp256k1Signing(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	require.NoError(t, err)
+	sig2, err := private.Sign(msg2)
+	require.NoError(t, err)
+
+	bz, err := sig.Marshal()
+	assert.NoError(t, err)
+	bz2, err := sig2.Marshal()
+	assert.NoError(t, err)
+	assert.NotEqual(t, bz, bz2)
+
+	assert.True(t, public.Verify(msg, sig))
+	assert.False(t, public.Verify(msg, sig2))
+	assert.False(t, public.Verify(msg2, sig))
+	assert.True(t, public.Verify(msg2, sig2))
+	assert.False(t, public.Verify(msg, new(Signature)))
+	assert.False(t, public.Verify(msg, nil))
+
+	// signatures of other key types never match
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	require.NoError(t, err)
+	assert.False(t, public.Verify(msg, edsig))
+
+	// the malleated signature (with high S) is refused
+	raw := sig.GetSecp256K1()
+	s := new(big.Int).SetBytes(raw[32:])
+	s.Sub(btcec.S256().N, s)
+	malleated := make([]byte, 64)
+	copy(malleated, raw[:32])
+	sb := s.Bytes()
+	copy(malleated[64-len(sb):], sb)
+	assert.False(t, public.Verify(msg, &Signature{Sig: &Signature_Secp256K1{Secp256K1: malleated}}))
+}
+
+func TestSecp256k1Address(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	pub := private.PublicKey()
+	pub2 := GenPrivKeySecp256k1().PublicKey()
+
+	assert.Len(t, pub.GetSecp256K1(), 33)
+	assert.NoError(t, pub.Condition().Validate())
+	_, typ, _, err := pub.Condition().Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "secp256k", typ)
+	assert.NotEqual(t, pub.Condition(), pub2.Condition())
+
+	bz, err := pub.Marshal()
+	require.Nil(t, err)
+	var read PublicKey
+	err = read.Unmarshal(bz)
+	require.Nil(t, err)
+	assert.Equal(t, read.Condition(), pub.Condition())
+
+	// the uncompressed form of the same key is refused
+	key, err := btcec.ParsePubKey(pub.GetSecp256K1(), btcec.S256())
+	require.NoError(t, err)
+	uncompressed := &PublicKey{Pub: &PublicKey_Secp256K1{Secp256K1: key.SerializeUncompressed()}}
+	sig, err := private.Sign([]byte("foobar"))
+	require.NoError(t, err)
+	assert.True(t, pub.Verify([]byte("foobar"), sig))
+	assert.False(t, uncompressed.Verify([]byte("foobar"), sig))
+}
+
+func TestSecp256k1InvalidPrivateKey(t *testing.T) {
+	valid := GenPrivKeySecp256k1().GetSecp256K1()
+	cases := map[string][]byte{
+		"empty":     nil,
+		"too short": valid[:31],
+		"too long":  append(append([]byte(nil), valid...), 0),
+	}
+	for testName, bz := range cases {
+		t.Run(testName, func(t *testing.T) {
+			private := &PrivateKey{Priv: &PrivateKey_Secp256K1{Secp256K1: bz}}
+			_, err := private.Sign([]byte("foobar"))
+			assert.Error(t, err)
+			assert.Nil(t, private.PublicKey())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package errors
+
+import "sort"
+
+// Registration describes a registered root error, so that clients
+// can map the ABCI codes returned by the application.
+type Registration struct {
+	Code        uint32 `json:"code"`
+	Description string `json:"description"`
+	// Extension is the name of the range the code belongs to,
+	// empty if it was registered without a range.
+	Extension string `json:"extension"`
+}
+
+// Registered returns all root errors registered so far, sorted
+// by code. As errors are registered during the startup, this
+// describes all errors of the packages linked into the program.
+func Registered() []Registration {
+	res := make([]Registration, 0, len(usedCodes))
+	for code, e := range usedCodes {
+		res = append(res, Registration{
+			Code:        code,
+			Description: e.desc,
+			Extension:   owners[code],
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Code < res[j].Code })
+	return res
+}
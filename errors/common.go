@@ -8,14 +8,19 @@ import (
 )
 
 // ABCI Response Codes
-// Base SDK reserves 0 ~ 99.
+// weave reserves 1 ~ 9, extensions reserve their own range
+// of codes with RegisterRange.
+//
+// These are the codes of the root errors declared in errors.go,
+// so errors created with the legacy functions below are
+// equal (see Is) to the matching root error.
 const (
 	CodeInternalErr         uint32 = 1
-	CodeTxParseError               = 2
-	CodeUnauthorized               = 3
-	CodeUnknownRequest             = 4
-	CodeUnrecognizedAddress        = 5
-	CodeInvalidChainID             = 6
+	CodeUnauthorized               = 2
+	CodeTxParseError               = 6
+	CodeUnknownRequest             = 7
+	CodeUnrecognizedAddress        = 8
+	CodeInvalidChainID             = 9
 	CodePanic                      = 111222 // TODO: use maxint or such?
 )
 
@@ -51,13 +56,32 @@ func HasErrorCode(err error, code uint32) bool {
 //
 // We want the whole stack trace for logging
 // but should show nothing over the ABCI interface....
+// Call it from a deferred function, so the returned error
+// holds the stack of the function that panicked (use %+v
+// to print it). Redact removes the error before it is
+// returned over ABCI.
 func NormalizePanic(p interface{}) error {
-	// TODO, handle this better??? for stack traces
-	// if err, isErr := p.(error); isErr {
-	// 	return Wrap(err, "normalized panic")
-	// }
-	msg := fmt.Sprintf("panic: %v", p)
-	return Error{code: CodePanic, desc: msg}
+	return &panicError{
+		msg:   fmt.Sprintf("panic: %v", p),
+		stack: panicCallers(),
+	}
+}
+
+// panicError is a recovered panic
+type panicError struct {
+	msg   string
+	stack errors.StackTrace
+}
+
+func (e *panicError) Error() string                 { return e.msg }
+func (e *panicError) ABCILog() string               { return e.msg }
+func (e *panicError) ABCICode() uint32              { return PanicErr.code }
+func (e *panicError) StackTrace() errors.StackTrace { return e.stack }
+
+// Format prints the panic message for %s and %v, and the message
+// followed by the stack trace of the panic for %+v
+func (e *panicError) Format(s fmt.State, verb rune) {
+	formatWithStack(s, verb, e.msg, e.stack)
 }
 
 // Redact will replace all panic errors with a generic message
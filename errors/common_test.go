@@ -63,21 +63,21 @@ func TestLog(t *testing.T) {
 		log string
 	}{
 		// make sure messages are nice, even if wrapped or not
-		{ErrTooLarge(), IsTooLargeErr, "(2) Input size too large"},
+		{ErrTooLarge(), IsTooLargeErr, "(6) Input size too large"},
 		{Wrap(ErrTooLarge(), ""), IsTooLargeErr, ": Input size too large"},
 		{Wrap(fmt.Errorf("wrapped"), ""), IsInternalErr, ": wrapped"},
 
 		// with code shouldn't change the error message
-		{WithCode(ErrUnauthorized(), CodeTxParseError), IsDecodingErr, "(2) Unauthorized"},
+		{WithCode(ErrUnauthorized(), CodeTxParseError), IsDecodingErr, "(6) Unauthorized"},
 
 		// with log should add some in front
 		{WithLog("Special", ErrUnauthorized(), CodeInternalErr), IsInternalErr, "(1) Special: Unauthorized"},
 
 		// verify some standard message types with prefixes
-		{ErrUnrecognizedAddress([]byte{0, 0x12, 0x77}), IsUnrecognizedAddressErr, "(5) 001277: Unrecognized Address"},
-		{ErrUnrecognizedCondition([]byte{0xF0, 0x0D, 0xCA, 0xFE}), IsUnrecognizedConditionErr, "(5) F00DCAFE: Unrecognized Condition"},
-		{ErrUnknownTxType("john_123"), IsUnknownTxTypeErr, "(4) string: Tx type unknown"},
-		{ErrUnknownTxType(t), IsUnknownTxTypeErr, "(4) *testing.T: Tx type unknown"},
+		{ErrUnrecognizedAddress([]byte{0, 0x12, 0x77}), IsUnrecognizedAddressErr, "(8) 001277: Unrecognized Address"},
+		{ErrUnrecognizedCondition([]byte{0xF0, 0x0D, 0xCA, 0xFE}), IsUnrecognizedConditionErr, "(8) F00DCAFE: Unrecognized Condition"},
+		{ErrUnknownTxType("john_123"), IsUnknownTxTypeErr, "(7) string: Tx type unknown"},
+		{ErrUnknownTxType(t), IsUnknownTxTypeErr, "(7) *testing.T: Tx type unknown"},
 	}
 
 	for i, tc := range cases {
@@ -102,12 +102,9 @@ func TestLog(t *testing.T) {
 			stack := fmt.Sprintf("%+v", tc.err)
 			// we should trim off unneeded stuff
 			withCode := "github.com/iov-one/weave/errors.WithCode\n"
-			// thisTest := "github.com/iov-one/weave/errors.TestLog\n"
+			thisTest := "github.com/iov-one/weave/errors.TestLog\n"
 			assert.False(t, strings.Contains(stack, withCode))
-			// TODO: this is failing, because stacktrace
-			// implementation is not present for the new error
-			// handing code.
-			// assert.True(t, strings.Contains(stack, thisTest))
+			assert.True(t, strings.Contains(stack, thisTest), stack)
 		})
 	}
 }
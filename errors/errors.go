@@ -6,30 +6,49 @@ import (
 	"github.com/pkg/errors"
 )
 
+// weaveCodes are the codes of the root errors declared in this package.
+// Extensions must register their errors in their own range.
+var weaveCodes = RegisterRange("weave", 1, 9)
+
 var (
 	// InternalErr represents a general case issue that cannot be
 	// categorized as any of the below cases.
 	// We start as 1 as 0 is reserved for non-errors
-	InternalErr = Register(1, "internal")
+	InternalErr = weaveCodes.Register(CodeInternalErr, "internal")
 
 	// UnauthorizedErr is used whenever a request without sufficient
 	// authorization is handled.
-	UnauthorizedErr = Register(2, "unauthorized")
+	UnauthorizedErr = weaveCodes.Register(CodeUnauthorized, "unauthorized")
 
 	// NotFoundErr is used when a requested operation cannot be completed
 	// due to missing data.
-	NotFoundErr = Register(3, "not found")
+	NotFoundErr = weaveCodes.Register(3, "not found")
 
 	// InvalidMsgErr is returned whenever an event is invalid and cannot be
 	// handled.
-	InvalidMsgErr = Register(4, "invalid message")
+	InvalidMsgErr = weaveCodes.Register(4, "invalid message")
 
 	// InvalidModelErr is returned whenever a message is invalid and cannot
 	// be used (ie. persisted).
-	InvalidModelErr = Register(5, "invalid model")
+	InvalidModelErr = weaveCodes.Register(5, "invalid model")
+
+	// TxParseErr is returned when a transaction cannot be decoded.
+	TxParseErr = weaveCodes.Register(CodeTxParseError, "tx parse error")
+
+	// UnknownRequestErr is returned for transactions or queries
+	// that no handler is registered for.
+	UnknownRequestErr = weaveCodes.Register(CodeUnknownRequest, "unknown request")
+
+	// UnrecognizedAddressErr is returned for malformed addresses
+	// and conditions.
+	UnrecognizedAddressErr = weaveCodes.Register(CodeUnrecognizedAddress, "unrecognized address")
+
+	// InvalidChainIDErr is returned when the chain ID is malformed
+	// or does not match.
+	InvalidChainIDErr = weaveCodes.Register(CodeInvalidChainID, "invalid chain id")
 
 	// PanicErr is only set when we recover from a panic, so we know to redact potentially sensitive system info
-	PanicErr = Register(111222, "panic")
+	PanicErr = register(CodePanic, "panic", "weave")
 )
 
 // Register returns an error instance that should be used as the base for
@@ -39,8 +58,19 @@ var (
 // declare custom codes. This function ensures that no error code is used
 // twice. Attempt to reuse an error code results in panic.
 //
+// Extensions should reserve a range of codes with RegisterRange and use
+// Range.Register instead, codes within a reserved range cannot be
+// registered with this function.
+//
 // Use this function only during a program startup phase.
 func Register(code uint32, description string) Error {
+	if r, ok := rangeOf(code); ok {
+		panic(fmt.Sprintf("error code %d is reserved by %s", code, r.name))
+	}
+	return register(code, description, "")
+}
+
+func register(code uint32, description, extension string) Error {
 	if e, ok := usedCodes[code]; ok {
 		panic(fmt.Sprintf("error with code %d is already registered: %q", code, e.desc))
 	}
@@ -49,12 +79,83 @@ func Register(code uint32, description string) Error {
 		desc: description,
 	}
 	usedCodes[err.code] = err
+	owners[err.code] = extension
 	return err
 }
 
 // usedCodes is keeping track of used codes to ensure uniqueness.
 var usedCodes = map[uint32]Error{}
 
+// owners is keeping track of the extension that registered each code.
+var owners = map[uint32]string{}
+
+// Range is a block of error codes reserved by a single extension.
+type Range struct {
+	name string
+	from uint32
+	to   uint32
+}
+
+// RegisterRange reserves all codes from first to last (inclusive) for the
+// named extension. Overlapping ranges result in panic, so conflicting
+// extensions are detected at startup.
+//
+// Use this function only during a program startup phase.
+func RegisterRange(name string, first, last uint32) Range {
+	if first == 0 || last < first {
+		panic(fmt.Sprintf("invalid error code range %d-%d for %s", first, last, name))
+	}
+	r := Range{name: name, from: first, to: last}
+	for _, other := range usedRanges {
+		if r.from <= other.to && other.from <= r.to {
+			panic(fmt.Sprintf("error codes %d-%d of %s overlap with %d-%d of %s",
+				r.from, r.to, r.name, other.from, other.to, other.name))
+		}
+	}
+	for code, e := range usedCodes {
+		if r.contains(code) {
+			panic(fmt.Sprintf("error codes %d-%d of %s include registered code %d: %q",
+				r.from, r.to, r.name, code, e.desc))
+		}
+	}
+	usedRanges = append(usedRanges, r)
+	return r
+}
+
+// Register returns a root error, as the Register function does. The
+// code must belong to the range.
+func (r Range) Register(code uint32, description string) Error {
+	if !r.contains(code) {
+		panic(fmt.Sprintf("error code %d is outside of the range %d-%d of %s",
+			code, r.from, r.to, r.name))
+	}
+	return register(code, description, r.name)
+}
+
+func (r Range) contains(code uint32) bool {
+	return r.from <= code && code <= r.to
+}
+
+// usedRanges is keeping track of reserved ranges to ensure they
+// do not overlap.
+var usedRanges []Range
+
+// rangeOf returns the range the code belongs to, if any
+func rangeOf(code uint32) (Range, bool) {
+	for _, r := range usedRanges {
+		if r.contains(code) {
+			return r, true
+		}
+	}
+	return Range{}, false
+}
+
+// isRegistered returns true if a root error with this code exists.
+func isRegistered(code uint32) bool {
+	_, ok := usedCodes[code]
+	return ok
+}
+
 // Error represents a root error.
 //
 // Weave framework is using root error to categorize issues. Each instance
@@ -97,9 +198,19 @@ func Wrap(err error, description string) TMError {
 	if err == nil {
 		return nil
 	}
+	// Keep the stack of the wrapped error if it has one, as this
+	// is where the issue originated.
+	var stack errors.StackTrace
+	if st, ok := err.(stackTracer); ok {
+		stack = trimStack(st.StackTrace())
+	}
+	if len(stack) == 0 {
+		stack = callers()
+	}
 	return &wrappedError{
 		Parent: err,
 		Msg:    description,
+		stack:  stack,
 	}
 }
 
@@ -108,17 +219,24 @@ type wrappedError struct {
 	Msg string
 	// The underlying error that triggered this one.
 	Parent error
+	// Where the first error of the chain was created.
+	stack errors.StackTrace
 }
 
 type coder interface {
 	ABCICode() uint32
 }
 
+// StackTrace returns the stack of the place where the first error of
+// the chain was wrapped or created with Error.New
 func (e *wrappedError) StackTrace() errors.StackTrace {
-	// TODO: this is either to be implemented or expectation of it being
-	// present removed completely. As this is an early stage of
-	// refactoring, this is left unimplemented for now.
-	return nil
+	return e.stack
+}
+
+// Format prints the error message for %s and %v, and the message
+// followed by the stack trace for %+v
+func (e *wrappedError) Format(s fmt.State, verb rune) {
+	formatWithStack(s, verb, e.Error(), e.stack)
 }
 
 func (e *wrappedError) Error() string {
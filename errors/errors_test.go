@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -142,3 +143,129 @@ func TestWrapEmpty(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestStackTrace(t *testing.T) {
+	cases := map[string]struct {
+		err       error
+		wantFrame string
+	}{
+		"wrap": {
+			err:       Wrap(NotFoundErr, "404"),
+			wantFrame: "TestStackTrace",
+		},
+		"new": {
+			err:       NotFoundErr.New("404"),
+			wantFrame: "TestStackTrace",
+		},
+		"wrap keeps the original stack": {
+			err:       Wrap(createError(), "outer"),
+			wantFrame: "createError",
+		},
+		"panic": {
+			err:       recoveredPanic(),
+			wantFrame: "panicky",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			st := tc.err.(stackTracer).StackTrace()
+			if len(st) == 0 {
+				t.Fatal("no stack trace")
+			}
+			if name := funcName(st[0]); !strings.HasSuffix(name, tc.wantFrame) {
+				t.Fatalf("want stack to start at %s, got %s", tc.wantFrame, name)
+			}
+			full := fmt.Sprintf("%+v", tc.err)
+			if !strings.HasPrefix(full, tc.err.Error()+"\n") || !strings.Contains(full, "errors_test.go") {
+				t.Fatalf("unexpected %%+v output: %s", full)
+			}
+			if msg := fmt.Sprintf("%v", tc.err); msg != tc.err.Error() {
+				t.Fatalf("want %%v to print the message, got %s", msg)
+			}
+		})
+	}
+}
+
+func TestPkgPrefix(t *testing.T) {
+	if pkgPrefix != "github.com/iov-one/weave/errors." {
+		t.Fatalf("unexpected prefix %q", pkgPrefix)
+	}
+}
+
+func createError() error {
+	return NotFoundErr.New("inner")
+}
+
+func recoveredPanic() (err error) {
+	defer Recover(&err)
+	panicky()
+	return nil
+}
+
+func panicky() {
+	panic("boom")
+}
+
+func TestRegisterRange(t *testing.T) {
+	r := RegisterRange("test", 900000, 900009)
+	inRange := r.Register(900001, "in range")
+	if inRange.ABCICode() != 900001 {
+		t.Fatalf("unexpected code %d", inRange.ABCICode())
+	}
+
+	cases := map[string]func(){
+		"overlapping range":       func() { RegisterRange("other", 900005, 900020) },
+		"range with used code":    func() { RegisterRange("other", 111000, 112000) },
+		"empty range":             func() { RegisterRange("other", 900050, 900040) },
+		"code outside of range":   func() { r.Register(900010, "outside") },
+		"reused code":             func() { r.Register(900001, "again") },
+		"plain register in range": func() { Register(900002, "plain") },
+		"code of another range":   func() { weaveCodes.Register(900003, "not ours") },
+	}
+	for testName, fn := range cases {
+		t.Run(testName, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("want panic")
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestLegacyErrorsAreRegistered(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		root Error
+	}{
+		"unauthorized":         {ErrUnauthorized(), UnauthorizedErr},
+		"invalid signature":    {ErrInvalidSignature(), UnauthorizedErr},
+		"decoding":             {ErrDecoding(), TxParseErr},
+		"unknown tx type":      {ErrUnknownTxType(1), UnknownRequestErr},
+		"unrecognized address": {ErrUnrecognizedAddress(nil), UnrecognizedAddressErr},
+		"invalid chain id":     {ErrInvalidChainID("x"), InvalidChainIDErr},
+		"internal":             {ErrInternal("boom"), InternalErr},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if errCode(tc.err) != tc.root.code {
+				t.Fatalf("want code %d, got %d", tc.root.code, errCode(tc.err))
+			}
+			// internal errors are never equal, unless the same instance
+			if tc.root.code != InternalErr.code && !Is(tc.err, tc.root) {
+				t.Fatal("legacy error must be equal to the root error")
+			}
+		})
+	}
+}
+
+func TestUnregisteredCode(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("want panic")
+		}
+	}()
+	WithCode(errors.New("unregistered"), 987654)
+}
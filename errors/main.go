@@ -21,7 +21,10 @@ type TMError interface {
 // New creates an error with the given message and a stacktrace,
 // and sets the code and log,
 // overriding the state if err was already TMError
+//
+// The code must belong to a registered root error (see Register).
 func New(log string, code uint32) error {
+	mustBeRegistered(code)
 	// create a new error with stack trace and attach a code
 	st := errors.New(log).(stackTracer)
 	return tmerror{
@@ -33,7 +36,12 @@ func New(log string, code uint32) error {
 
 // WithCode adds a stacktrace if necessary and sets the code and msg,
 // overriding the code if err was already TMError
+//
+// The code must belong to a registered root error (see Register),
+// so that clients can map every code returned by the application.
+// Unregistered codes result in panic.
 func WithCode(err error, code uint32) TMError {
+	mustBeRegistered(code)
 	// add a stack only if not present
 	st, ok := err.(stackTracer)
 	if !ok {
@@ -57,6 +65,12 @@ func WithLog(prefix string, err error, code uint32) TMError {
 	return WithCode(e2, code)
 }
 
+func mustBeRegistered(code uint32) {
+	if !isRegistered(code) {
+		panic(fmt.Sprintf("error code %d is not registered", code))
+	}
+}
+
 //////////////////////////////////////////////////
 // tmerror is generic implementation of TMError
 
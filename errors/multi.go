@@ -0,0 +1,152 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Field marks the error as caused by the field at the given path, eg.
+// "amount" or "msgs.2". Paths of nested errors are joined with a dot,
+// so Field("msgs.2", Field("amount", err)) reports "msgs.2.amount".
+//
+// If err is nil, this returns nil, so the result of a validation can
+// be passed directly.
+func Field(path string, err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *fieldError:
+		return &fieldError{path: path + "." + e.path, err: e.err}
+	case *MultiError:
+		res := &MultiError{errs: make([]error, len(e.errs))}
+		for i, fe := range e.errs {
+			res.errs[i] = Field(path, fe)
+		}
+		return res
+	}
+	return &fieldError{path: path, err: err}
+}
+
+// fieldError is an error related to a single field
+type fieldError struct {
+	path string
+	err  error
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.path, e.err.Error())
+}
+
+func (e *fieldError) ABCICode() uint32 {
+	if c, ok := e.err.(coder); ok {
+		return c.ABCICode()
+	}
+	return InternalErr.code
+}
+
+func (e *fieldError) ABCILog() string {
+	type logger interface {
+		ABCILog() string
+	}
+	if l, ok := e.err.(logger); ok {
+		return fmt.Sprintf("%s: %s", e.path, l.ABCILog())
+	}
+	return e.Error()
+}
+
+func (e *fieldError) Cause() error {
+	return e.err
+}
+
+// Append combines all given errors into a MultiError, so that all
+// problems can be reported at once instead of only the first one.
+// MultiErrors are flattened and nil errors are ignored.
+//
+// It returns nil if all errors are nil and a single error unchanged,
+// so a lone problem is reported exactly as before.
+func Append(errs ...error) error {
+	var all []error
+	for _, err := range errs {
+		switch e := err.(type) {
+		case nil:
+		case *MultiError:
+			all = append(all, e.errs...)
+		default:
+			all = append(all, err)
+		}
+	}
+	switch len(all) {
+	case 0:
+		return nil
+	case 1:
+		return all[0]
+	}
+	return &MultiError{errs: all}
+}
+
+// MultiError holds several errors, usually one for each invalid field
+// (see Field). It is created with Append.
+//
+// The ABCI code and the cause are those of the first error, so existing
+// checks of a single error keep working, while ABCILog lists all of them.
+type MultiError struct {
+	errs []error
+}
+
+var _ TMError = (*MultiError)(nil)
+
+// Errors returns all combined errors, in the order they were appended
+func (m *MultiError) Errors() []error {
+	return append([]error(nil), m.errs...)
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		msgs[i] = err.Error()
+	}
+	return m.join(msgs)
+}
+
+// ABCILog lists the logs of all errors
+func (m *MultiError) ABCILog() string {
+	type logger interface {
+		ABCILog() string
+	}
+	msgs := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		if l, ok := err.(logger); ok {
+			msgs[i] = l.ABCILog()
+		} else {
+			msgs[i] = err.Error()
+		}
+	}
+	return m.join(msgs)
+}
+
+func (m *MultiError) join(msgs []string) string {
+	return fmt.Sprintf("%d errors: %s", len(msgs), strings.Join(msgs, "; "))
+}
+
+// ABCICode returns the code of the first error
+func (m *MultiError) ABCICode() uint32 {
+	if c, ok := m.errs[0].(coder); ok {
+		return c.ABCICode()
+	}
+	return InternalErr.code
+}
+
+// Cause returns the first error
+func (m *MultiError) Cause() error {
+	return m.errs[0]
+}
+
+// StackTrace returns the stack of the first error, if it has one
+func (m *MultiError) StackTrace() errors.StackTrace {
+	if st, ok := m.errs[0].(stackTracer); ok {
+		return st.StackTrace()
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		wantNil  bool
+		wantCode uint32
+		wantMsg  string
+		wantLog  string
+		wantLen  int
+	}{
+		"nothing": {
+			err:     Append(nil, Field("amount", nil)),
+			wantNil: true,
+		},
+		"single error is not wrapped": {
+			err:      Append(nil, NotFoundErr),
+			wantCode: NotFoundErr.code,
+			wantMsg:  "not found",
+			wantLog:  "not found",
+		},
+		"single field": {
+			err:      Append(Field("amount", InvalidMsgErr.New("negative"))),
+			wantCode: InvalidMsgErr.code,
+			wantMsg:  "amount: negative: invalid message",
+			wantLog:  "amount: negative: invalid message",
+		},
+		"several fields": {
+			err: Append(
+				Field("amount", InvalidMsgErr.New("negative")),
+				nil,
+				Field("memo", NotFoundErr),
+			),
+			wantCode: InvalidMsgErr.code,
+			wantMsg:  "2 errors: amount: negative: invalid message; memo: not found",
+			wantLog:  "2 errors: amount: negative: invalid message; memo: not found",
+			wantLen:  2,
+		},
+		"nested paths are flattened": {
+			err: Append(
+				Field("msgs.0", Append(Field("amount", NotFoundErr), Field("memo", NotFoundErr))),
+				Field("msgs.1", errors.New("stdlib")),
+			),
+			wantCode: NotFoundErr.code,
+			wantMsg:  "3 errors: msgs.0.amount: not found; msgs.0.memo: not found; msgs.1: stdlib",
+			wantLog:  "3 errors: msgs.0.amount: not found; msgs.0.memo: not found; msgs.1: stdlib",
+			wantLen:  3,
+		},
+		"internal first error": {
+			err:      Append(errors.New("stdlib"), NotFoundErr),
+			wantCode: InternalErr.code,
+			wantMsg:  "2 errors: stdlib; not found",
+			wantLog:  "2 errors: stdlib; not found",
+			wantLen:  2,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if tc.wantNil {
+				if tc.err != nil {
+					t.Fatalf("want nil, got %v", tc.err)
+				}
+				return
+			}
+			if code := errCode(tc.err); code != tc.wantCode {
+				t.Fatalf("want %d code, got %d", tc.wantCode, code)
+			}
+			if msg := tc.err.Error(); msg != tc.wantMsg {
+				t.Errorf("want %q, got %q", tc.wantMsg, msg)
+			}
+			if log := errLog(tc.err); log != tc.wantLog {
+				t.Errorf("want %q log message, got %q", tc.wantLog, log)
+			}
+			m, ok := tc.err.(*MultiError)
+			if tc.wantLen == 0 {
+				if ok {
+					t.Fatal("unexpected MultiError")
+				}
+				return
+			}
+			if !ok || len(m.Errors()) != tc.wantLen {
+				t.Fatalf("want %d errors, got %#v", tc.wantLen, tc.err)
+			}
+		})
+	}
+}
+
+func TestMultiErrorCause(t *testing.T) {
+	err := Append(Field("sender", ErrUnauthorized()), Field("amount", ErrTooLarge()))
+	if !IsUnauthorizedErr(err) || !IsSameError(errUnauthorized, err) {
+		t.Fatalf("want the first error to be the cause: %v", err)
+	}
+	if IsTooLargeErr(err) {
+		t.Fatal("only the first error is the cause")
+	}
+}
@@ -0,0 +1,98 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxStackDepth is the maximum number of frames recorded
+const maxStackDepth = 32
+
+// callers returns the stack of the caller of the function calling
+// callers, with all frames of this package removed from the top
+func callers() errors.StackTrace {
+	var pcs [maxStackDepth]uintptr
+	// skip runtime.Callers, callers and its caller
+	n := runtime.Callers(3, pcs[:])
+	return trimStack(asStackTrace(pcs[:n]))
+}
+
+// panicCallers returns the stack of the function that panicked.
+// It must be called from a deferred function while panicking,
+// as only then the stack still contains the panicking frames.
+func panicCallers() errors.StackTrace {
+	var pcs [maxStackDepth]uintptr
+	n := runtime.Callers(2, pcs[:])
+	st := asStackTrace(pcs[:n])
+	for i, f := range st {
+		if funcName(f) == "runtime.gopanic" {
+			return trimStack(st[i+1:])
+		}
+	}
+	// not panicking, this is the best we can do
+	return trimStack(st)
+}
+
+func asStackTrace(pcs []uintptr) errors.StackTrace {
+	st := make(errors.StackTrace, len(pcs))
+	for i, pc := range pcs {
+		st[i] = errors.Frame(pc)
+	}
+	return st
+}
+
+// pkgPrefix prefixes the names of all functions of this package,
+// "github.com/iov-one/weave/errors." unless it is vendored
+var pkgPrefix = strings.TrimSuffix(runtime.FuncForPC(reflect.ValueOf(funcName).Pointer()).Name(), "funcName")
+
+// trimStack removes the frames of this package, where errors are
+// created, and the runtime frames added on panics from the top
+func trimStack(st errors.StackTrace) errors.StackTrace {
+	for len(st) > 0 && isInternalFrame(st[0]) {
+		st = st[1:]
+	}
+	return st
+}
+
+func isInternalFrame(f errors.Frame) bool {
+	name := funcName(f)
+	switch {
+	case strings.HasPrefix(name, "runtime."):
+		return true
+	case strings.HasPrefix(name, pkgPrefix):
+		// the tests of this package create errors as any user would
+		return !matchesFile(f, "_test.go")
+	default:
+		return false
+	}
+}
+
+func funcName(f errors.Frame) string {
+	fn := runtime.FuncForPC(uintptr(f) - 1)
+	if fn == nil {
+		return ""
+	}
+	return fn.Name()
+}
+
+// formatWithStack implements fmt.Formatter for errors with a stack.
+// %s and %v print the message, %+v the message followed by the stack.
+func formatWithStack(s fmt.State, verb rune, msg string, st errors.StackTrace) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%s%+v", msg, st)
+			return
+		}
+		io.WriteString(s, msg)
+	case 's':
+		io.WriteString(s, msg)
+	case 'q':
+		fmt.Fprintf(s, "%q", msg)
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/x"
@@ -64,7 +65,7 @@ func Router(authFn x.Authenticator) app.Router {
 }
 
 // QueryRouter returns a default query router,
-// allowing access to "/wallets", "/auth", and "/"
+// allowing access to "/wallets", "/auth", "/gconf" and "/"
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	r.RegisterAll(
@@ -72,6 +73,7 @@ func QueryRouter() weave.QueryRouter {
 		cash.RegisterQuery,
 		sigs.RegisterQuery,
 		orm.RegisterQuery,
+		gconf.RegisterQuery,
 	)
 	return r
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -38,7 +39,7 @@ func testInitChain(t *testing.T, myApp app.BaseApp, addr string) {
 			},
 		},
 		"gconf": dict{
-			cash.GconfCollectorAddress: "fake-collector-address",
+			cash.GconfCollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
 			cash.GconfMinimalFee:       x.Coin{Whole: 0}, // no fee
 		},
 	})
@@ -212,4 +213,11 @@ func TestApp(t *testing.T) {
 	assert.Equal(t, "ETH", second.Coins[0].Ticker)
 	assert.Equal(t, int64(100), second.Coins[1].Whole)
 	assert.Equal(t, "FRNK", second.Coins[1].Ticker)
+
+	// the same can be read as json
+	qres := myApp.Query(abci.RequestQuery{Path: "/wallets?json", Data: addr2})
+	require.Equal(t, uint32(0), qres.Code, "%#v", qres)
+	want := fmt.Sprintf(`[{"key":"%X","value":{"coins":[{"ticker":"ETH","whole":2000},{"ticker":"FRNK","whole":100}]}}]`,
+		cash.NewBucket().DBKey(addr2))
+	assert.Equal(t, want, string(qres.Value))
 }
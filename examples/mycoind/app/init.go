@@ -56,7 +56,7 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 			},
 		},
 		"gconf": dict{
-			cash.GconfCollectorAddress: "fake-collector-address",
+			cash.GconfCollectorAddress: addr,             // fees go to the rich account
 			cash.GconfMinimalFee:       x.Coin{Whole: 0}, // no fee
 		},
 	})
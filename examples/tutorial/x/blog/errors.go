@@ -15,6 +15,15 @@ const (
 	CodeInvalidBlog    uint32 = 403
 )
 
+var (
+	codes = errors.RegisterRange("blog", 400, 420)
+
+	InvalidTextErr    = codes.Register(CodeInvalidText, "invalid text")
+	InvalidAuthorErr  = codes.Register(CodeInvalidAuthor, "invalid author")
+	NegativeNumberErr = codes.Register(CodeNegativeNumber, "negative number")
+	InvalidBlogErr    = codes.Register(CodeInvalidBlog, "invalid blog")
+)
+
 var (
 	errInvalidTitle       = fmt.Errorf("Title is too long or too short")
 	errInvalidText        = fmt.Errorf("Text is too long or too short")
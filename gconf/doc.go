@@ -6,6 +6,18 @@ in-database configuration.
 This package allows to load configuration from a genesis file and access it via
 set of helper functions (`String`, `Int`, `Duration` etc).
 
+Extensions declare the properties they use with `Register`, giving each a
+type, an optional default and an optional validator. The genesis `Initializer`
+validates all values against these schemas and fails if a property is unknown,
+invalid or required but missing, so that a misconfigured chain does not start.
+Missing properties with a default are stored with the default value.
+
+Registered properties can be changed on a running chain with an
+`UpdateConfigurationMsg`. The message must be signed by the owner of the
+property, stored for the property name or for a prefix ending with ":" (eg.
+"cash:"). Owners are set in genesis under "gconf_owners", so that for example a
+multisig contract can own all fee settings.
+
 Not being able to get a configuration value is a critical condition for the
 application and there is no recovery path for the client. Application must be
 terminated and configured correctly. This is why any failure results in a
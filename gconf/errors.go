@@ -0,0 +1,47 @@
+package gconf
+
+import (
+	"fmt"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// ABCI Response Codes
+// gconf takes 50-59
+const (
+	CodeUnknownProperty = 50
+	CodeInvalidValue    = 51
+	CodeMissingProperty = 52
+)
+
+var (
+	codes = errors.RegisterRange("gconf", 50, 59)
+
+	UnknownPropertyErr = codes.Register(CodeUnknownProperty, "unknown configuration property")
+	InvalidValueErr    = codes.Register(CodeInvalidValue, "invalid configuration value")
+	MissingPropertyErr = codes.Register(CodeMissingProperty, "missing configuration property")
+)
+
+func ErrUnknownProperty(propName string) error {
+	return UnknownPropertyErr.New(propName)
+}
+func IsUnknownPropertyErr(err error) bool {
+	return errors.HasErrorCode(err, CodeUnknownProperty)
+}
+
+func ErrInvalidValue(propName string, reason error) error {
+	return InvalidValueErr.New(fmt.Sprintf("%s: %s", propName, reason))
+}
+func ErrMissingName() error {
+	return InvalidValueErr.New("missing property name")
+}
+func IsInvalidValueErr(err error) bool {
+	return errors.HasErrorCode(err, CodeInvalidValue)
+}
+
+func ErrMissingProperty(propName string) error {
+	return MissingPropertyErr.New(propName)
+}
+func IsMissingPropertyErr(err error) bool {
+	return errors.HasErrorCode(err, CodeMissingProperty)
+}
@@ -68,8 +68,10 @@ func Coin(confStore Store, propName string) x.Coin {
 }
 
 func loadInto(confStore Store, propName string, dest interface{}) {
-	key := []byte("gconf:" + propName)
-	raw := confStore.Get(key)
+	raw := confStore.Get(propKey(propName))
+	if raw == nil {
+		raw = defaultValue(propName)
+	}
 	if raw == nil {
 		panic(fmt.Sprintf("cannot load %q configuration: not found", propName))
 	}
@@ -77,3 +79,8 @@ func loadInto(confStore Store, propName string, dest interface{}) {
 		panic(fmt.Sprintf("cannot load %q configuration: %s", propName, err))
 	}
 }
+
+// propKey returns the database key of the property
+func propKey(propName string) []byte {
+	return []byte("gconf:" + propName)
+}
@@ -2,9 +2,10 @@ package gconf
 
 import (
 	"encoding/json"
-	"fmt"
+	"sort"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 )
 
 // Initializer fulfils the InitStater interface to load data from
@@ -13,17 +14,61 @@ type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
 
-// FromGenesis will parse initial account info from genesis
-// and save it to the database
+// FromGenesis will parse initial configuration from genesis
+// and save it to the database.
+//
+// All values are validated against the registered schemas. It fails
+// if a property is not registered, has an invalid value or is required
+// but not set, so that a misconfigured chain cannot start. Properties
+// that are not set are stored with their default value.
 func (Initializer) FromGenesis(opts weave.Options, db weave.KVStore) error {
-	conf := make(map[string]interface{})
+	conf := make(map[string]json.RawMessage)
 	err := opts.ReadOptions("gconf", &conf)
 	if err != nil {
 		return err
 	}
 
-	for name, value := range conf {
-		if err := SetValue(db, name, value); err != nil {
+	// check all properties before failing, to report all problems at once
+	var errs []error
+	for _, name := range registeredProps() {
+		raw, ok := conf[name]
+		if !ok {
+			raw = defaultValue(name)
+			if raw == nil {
+				errs = append(errs, ErrMissingProperty(name))
+				continue
+			}
+		}
+		value, err := validateValue(name, raw)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		db.Set(propKey(name), value)
+	}
+	unknown := make([]string, 0)
+	for name := range conf {
+		if _, ok := schemas[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs = append(errs, ErrUnknownProperty(name))
+	}
+	if err := errors.Append(errs...); err != nil {
+		return err
+	}
+
+	// owners map a property name or prefix (eg. "cash:")
+	// to the address allowed to update it
+	owners := make(map[string]weave.Address)
+	if err := opts.ReadOptions("gconf_owners", &owners); err != nil {
+		return err
+	}
+	bucket := NewOwnerBucket()
+	for pattern, addr := range owners {
+		if err := bucket.Save(db, NewOwner(pattern, addr)); err != nil {
 			return err
 		}
 	}
@@ -39,9 +84,8 @@ func (Initializer) FromGenesis(opts weave.Options, db weave.KVStore) error {
 func SetValue(db weave.KVStore, propName string, value interface{}) error {
 	raw, err := json.Marshal(value)
 	if err != nil {
-		return fmt.Errorf("cannot serialize %s: %s", propName, err)
+		return errors.Wrap(err, "cannot serialize "+propName)
 	}
-	key := []byte("gconf:" + propName)
-	db.Set(key, raw)
+	db.Set(propKey(propName), raw)
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 )
 
@@ -12,8 +13,11 @@ func TestGenesisInitializer(t *testing.T) {
 	const genesis = `
 		{
 			"gconf": {
-				"a-string": "hello",
-				"an-int": 321
+				"test:name": "hello",
+				"test:positive": 321
+			},
+			"gconf_owners": {
+				"cash:": "6161616161616161616161616161616161616161"
 			}
 		}
 	`
@@ -29,10 +33,85 @@ func TestGenesisInitializer(t *testing.T) {
 		t.Fatalf("cannot load genesis: %s", err)
 	}
 
-	if got := String(db, "a-string"); got != "hello" {
+	if got := String(db, "test:name"); got != "hello" {
 		t.Fatalf("unexpected value: %v", got)
 	}
-	if got := Int(db, "an-int"); got != 321 {
+	if got := Int(db, "test:positive"); got != 321 {
+		t.Fatalf("unexpected value: %v", got)
+	}
+	// properties not set in genesis are stored with their default
+	if got := db.Get(propKey("other:name")); string(got) != `"anonymous"` {
+		t.Fatalf("unexpected value: %s", got)
+	}
+
+	owner, err := NewOwnerBucket().OwnerOf(db, "cash:minimal_fee")
+	if err != nil {
+		t.Fatalf("cannot get owner: %s", err)
+	}
+	if want := weave.Address("aaaaaaaaaaaaaaaaaaaa"); !owner.Equals(want) {
+		t.Fatalf("unexpected owner: %v", owner)
+	}
+}
+
+func TestGenesisInitializerValidation(t *testing.T) {
+	cases := map[string]struct {
+		genesis string
+		wantErr []func(error) bool
+	}{
+		"missing required property": {
+			genesis: `{"gconf": {"test:name": "hello"}}`,
+			wantErr: []func(error) bool{IsMissingPropertyErr},
+		},
+		"no configuration": {
+			genesis: `{}`,
+			wantErr: []func(error) bool{IsMissingPropertyErr},
+		},
+		"invalid value": {
+			genesis: `{"gconf": {"test:positive": -1}}`,
+			wantErr: []func(error) bool{IsInvalidValueErr},
+		},
+		"wrong type": {
+			genesis: `{"gconf": {"test:positive": 1, "test:name": 4}}`,
+			wantErr: []func(error) bool{IsInvalidValueErr},
+		},
+		"unknown property": {
+			genesis: `{"gconf": {"test:positive": 1, "test:nmae": "typo"}}`,
+			wantErr: []func(error) bool{IsUnknownPropertyErr},
+		},
+		"all problems are reported": {
+			genesis: `{"gconf": {"test:name": 4, "test:nmae": "typo"}}`,
+			wantErr: []func(error) bool{IsInvalidValueErr, IsMissingPropertyErr, IsUnknownPropertyErr},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opts weave.Options
+			if err := json.Unmarshal([]byte(tc.genesis), &opts); err != nil {
+				t.Fatalf("cannot unmarshal genesis: %s", err)
+			}
+			err := Initializer{}.FromGenesis(opts, store.MemStore())
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			errs := []error{err}
+			if m, ok := err.(*errors.MultiError); ok {
+				errs = m.Errors()
+			}
+			if len(errs) != len(tc.wantErr) {
+				t.Fatalf("want %d errors, got %+v", len(tc.wantErr), err)
+			}
+			for i, want := range tc.wantErr {
+				if !want(errs[i]) {
+					t.Errorf("unexpected error %d: %s", i, errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLoadingDefault(t *testing.T) {
+	if got := String(store.MemStore(), "other:name"); got != "anonymous" {
 		t.Fatalf("unexpected value: %v", got)
 	}
 }
@@ -0,0 +1,79 @@
+package gconf
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x"
+)
+
+// RegisterRoutes will instantiate and register
+// all handlers in this package
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r.Handle(pathUpdateConfigurationMsg, NewUpdateConfigurationHandler(auth))
+}
+
+// NewUpdateConfigurationHandler creates a handler that updates
+// configuration properties signed by their owners
+func NewUpdateConfigurationHandler(auth x.Authenticator) weave.Handler {
+	return UpdateConfigurationHandler{
+		auth:   auth,
+		owners: NewOwnerBucket(),
+	}
+}
+
+// UpdateConfigurationHandler stores a new value of a registered
+// configuration property, if the tx is authorized by its owner
+type UpdateConfigurationHandler struct {
+	auth   x.Authenticator
+	owners OwnerBucket
+}
+
+var _ weave.Handler = UpdateConfigurationHandler{}
+
+func (h UpdateConfigurationHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (weave.CheckResult, error) {
+	var res weave.CheckResult
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return res, err
+	}
+	res.GasAllocated += updateCost
+	return res, nil
+}
+
+func (h UpdateConfigurationHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (weave.DeliverResult, error) {
+	var res weave.DeliverResult
+	msg, value, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return res, err
+	}
+	db.Set(propKey(msg.Name), value)
+	return res, nil
+}
+
+// validate returns the message and the normalized value to store
+func (h UpdateConfigurationHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateConfigurationMsg, []byte, error) {
+	rmsg, err := tx.GetMsg()
+	if err != nil {
+		return nil, nil, err
+	}
+	msg, ok := rmsg.(*UpdateConfigurationMsg)
+	if !ok {
+		return nil, nil, errors.ErrUnknownTxType(rmsg)
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	owner, err := h.owners.OwnerOf(db, msg.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if owner == nil || !h.auth.HasAddress(ctx, owner) {
+		return nil, nil, errors.ErrUnauthorized()
+	}
+
+	value, err := validateValue(msg.Name, msg.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, value, nil
+}
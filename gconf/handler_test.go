@@ -0,0 +1,149 @@
+package gconf
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x"
+)
+
+func init() {
+	Register("test:positive", Schema{
+		Type: int(0),
+		Validate: func(v interface{}) error {
+			if v.(int) <= 0 {
+				return fmt.Errorf("not positive")
+			}
+			return nil
+		},
+	})
+	Register("test:name", Schema{Default: "anonymous"})
+	Register("other:name", Schema{Default: "anonymous"})
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	var helpers x.TestHelpers
+	_, prefixOwner := helpers.MakeKey()
+	_, nameOwner := helpers.MakeKey()
+	_, stranger := helpers.MakeKey()
+
+	db := store.MemStore()
+	owners := NewOwnerBucket()
+	if err := owners.Save(db, NewOwner("test:", prefixOwner.Address())); err != nil {
+		t.Fatalf("cannot save owner: %s", err)
+	}
+	if err := owners.Save(db, NewOwner("test:name", nameOwner.Address())); err != nil {
+		t.Fatalf("cannot save owner: %s", err)
+	}
+
+	cases := map[string]struct {
+		signer  weave.Condition
+		msg     *UpdateConfigurationMsg
+		wantErr func(error) bool
+	}{
+		"prefix owner": {
+			signer: prefixOwner,
+			msg:    &UpdateConfigurationMsg{Name: "test:positive", Value: []byte(`12`)},
+		},
+		"name owner": {
+			signer: nameOwner,
+			msg:    &UpdateConfigurationMsg{Name: "test:name", Value: []byte(`"foo"`)},
+		},
+		"name owner takes precedence": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "test:name", Value: []byte(`"foo"`)},
+			wantErr: errors.IsUnauthorizedErr,
+		},
+		"not an owner": {
+			signer:  stranger,
+			msg:     &UpdateConfigurationMsg{Name: "test:positive", Value: []byte(`12`)},
+			wantErr: errors.IsUnauthorizedErr,
+		},
+		"property without owner": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "other:name", Value: []byte(`"foo"`)},
+			wantErr: errors.IsUnauthorizedErr,
+		},
+		"unregistered property": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "test:unknown", Value: []byte(`1`)},
+			wantErr: IsUnknownPropertyErr,
+		},
+		"wrong type": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "test:positive", Value: []byte(`"12"`)},
+			wantErr: IsInvalidValueErr,
+		},
+		"rejected by validator": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "test:positive", Value: []byte(`-4`)},
+			wantErr: IsInvalidValueErr,
+		},
+		"not JSON": {
+			signer:  prefixOwner,
+			msg:     &UpdateConfigurationMsg{Name: "test:positive", Value: []byte(`12 12`)},
+			wantErr: IsInvalidValueErr,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := NewUpdateConfigurationHandler(helpers.Authenticate(tc.signer))
+			tx := helpers.MockTx(tc.msg)
+			cache := db.CacheWrap()
+
+			_, err := h.Check(context.Background(), cache, tx)
+			if tc.wantErr != nil {
+				if !tc.wantErr(err) {
+					t.Fatalf("unexpected check error: %+v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("check failed: %+v", err)
+			}
+			if _, err := h.Deliver(context.Background(), cache, tx); err != nil {
+				t.Fatalf("deliver failed: %+v", err)
+			}
+			if got, want := string(cache.Get(propKey(tc.msg.Name))), string(tc.msg.Value); got != want {
+				t.Fatalf("want %s stored, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestOwnerOf(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+	_, b := helpers.MakeKey()
+
+	db := store.MemStore()
+	owners := NewOwnerBucket()
+	for pattern, c := range map[string]weave.Condition{"a:": a, "a:b:c": b} {
+		if err := owners.Save(db, NewOwner(pattern, c.Address())); err != nil {
+			t.Fatalf("cannot save owner: %s", err)
+		}
+	}
+
+	cases := map[string]weave.Address{
+		"a:b:c":   b.Address(),
+		"a:b:cd":  a.Address(),
+		"a:b":     a.Address(),
+		"a:":      a.Address(),
+		"a":       nil,
+		"b:a:b:c": nil,
+	}
+	for name, want := range cases {
+		got, err := owners.OwnerOf(db, name)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if !got.Equals(want) {
+			t.Errorf("%s: want %v, got %v", name, want, got)
+		}
+	}
+}
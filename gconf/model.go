@@ -0,0 +1,71 @@
+package gconf
+
+import (
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/orm"
+)
+
+var _ orm.CloneableData = (*Owner)(nil)
+
+// Validate ensures the owner has a valid address
+func (o *Owner) Validate() error {
+	return weave.Address(o.Address).Validate()
+}
+
+// Copy makes a new Owner with the same data
+func (o *Owner) Copy() orm.CloneableData {
+	return &Owner{
+		Address: append([]byte(nil), o.Address...),
+	}
+}
+
+// NewOwner returns an orm object granting the address the right to
+// update the property (or all properties with the prefix) pattern.
+func NewOwner(pattern string, owner weave.Address) orm.Object {
+	return orm.NewSimpleObj([]byte(pattern), &Owner{Address: owner})
+}
+
+// OwnerBucket stores the owners of configuration properties, keyed by
+// property name or by a prefix ending with ":".
+type OwnerBucket struct {
+	orm.Bucket
+}
+
+// NewOwnerBucket initializes an OwnerBucket
+func NewOwnerBucket() OwnerBucket {
+	return OwnerBucket{
+		Bucket: orm.NewBucket("gconfown", orm.NewSimpleObj(nil, new(Owner))),
+	}
+}
+
+// OwnerOf returns the address allowed to update the property, or nil
+// if nobody may update it. An owner of the full name takes precedence
+// over owners of prefixes, the longest prefix is checked first.
+func (b OwnerBucket) OwnerOf(db weave.ReadOnlyKVStore, propName string) (weave.Address, error) {
+	pattern := propName
+	for {
+		obj, err := b.Get(db, []byte(pattern))
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			return weave.Address(obj.Value().(*Owner).Address), nil
+		}
+		// drop the last segment, keeping the separator
+		i := strings.LastIndex(strings.TrimSuffix(pattern, ":"), ":")
+		if i < 0 {
+			return nil, nil
+		}
+		pattern = pattern[:i+1]
+	}
+}
+
+// Save enforces the proper type
+func (b OwnerBucket) Save(db weave.KVStore, obj orm.Object) error {
+	if _, ok := obj.Value().(*Owner); !ok {
+		return orm.ErrInvalidObject(obj.Value())
+	}
+	return b.Bucket.Save(db, obj)
+}
@@ -0,0 +1,33 @@
+package gconf
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/iov-one/weave"
+)
+
+const (
+	pathUpdateConfigurationMsg = "gconf/update"
+
+	updateCost int64 = 100
+)
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing
+func (UpdateConfigurationMsg) Path() string {
+	return pathUpdateConfigurationMsg
+}
+
+// Validate ensures a property is named and the value is JSON. The value
+// is checked against the schema of the property by the handler.
+func (m *UpdateConfigurationMsg) Validate() error {
+	if m.Name == "" {
+		return ErrMissingName()
+	}
+	if !json.Valid(m.Value) {
+		return ErrInvalidValue(m.Name, fmt.Errorf("not JSON"))
+	}
+	return nil
+}
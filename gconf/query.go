@@ -0,0 +1,84 @@
+package gconf
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// RegisterQuery registers the configuration under "/gconf".
+//
+// Values are returned as stored, JSON encoded, under their db key
+// ("gconf:<name>"). Querying by key returns the default of a registered
+// property that is not set. A prefix query (eg. "cash:") returns all
+// properties starting with it, using the default of every registered
+// property that is not set, ordered by key. The json modifier is accepted,
+// but does not change the result.
+func RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/gconf", queryHandler{})
+}
+
+type queryHandler struct{}
+
+var _ weave.QueryHandler = queryHandler{}
+
+func (queryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	// values are JSON already
+	mod, _ = weave.ParseQueryMod(mod)
+
+	switch mod {
+	case weave.KeyQueryMod:
+		key := propKey(string(data))
+		value := db.Get(key)
+		if value == nil {
+			value = defaultValue(string(data))
+		}
+		// return nothing on miss
+		if value == nil {
+			return nil, nil
+		}
+		return []weave.Model{{Key: key, Value: value}}, nil
+	case weave.PrefixQueryMod:
+		start := propKey(string(data))
+		itr := db.Iterator(start, prefixEnd(start))
+		defer itr.Close()
+
+		var res []weave.Model
+		for ; itr.Valid(); itr.Next() {
+			res = append(res, weave.Model{Key: itr.Key(), Value: itr.Value()})
+		}
+		for _, name := range registeredProps() {
+			if !strings.HasPrefix(name, string(data)) {
+				continue
+			}
+			key := propKey(name)
+			value := defaultValue(name)
+			if value == nil || db.Has(key) {
+				continue
+			}
+			res = append(res, weave.Model{Key: key, Value: value})
+		}
+		sort.Slice(res, func(i, j int) bool {
+			return bytes.Compare(res[i].Key, res[j].Key) < 0
+		})
+		return res, nil
+	default:
+		return nil, errors.UnknownRequestErr.New("not implemented: " + mod)
+	}
+}
+
+// prefixEnd returns the first key after all keys starting with prefix,
+// or nil if there is none
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
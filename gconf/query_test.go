@@ -0,0 +1,112 @@
+package gconf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+)
+
+func TestQuery(t *testing.T) {
+	db := store.MemStore()
+	for name, value := range map[string]interface{}{
+		"test:positive": 3,
+		"test:name":     "hello",
+		"other:name":    "world",
+	} {
+		if err := SetValue(db, name, value); err != nil {
+			t.Fatalf("cannot set %s: %s", name, err)
+		}
+	}
+	db.Set([]byte("gcong:name"), []byte(`"not configuration"`))
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	h := qr.Handler("/gconf")
+
+	cases := map[string]struct {
+		mod  string
+		data string
+		want []weave.Model
+	}{
+		"key": {
+			data: "test:name",
+			want: []weave.Model{weave.Pair([]byte("gconf:test:name"), []byte(`"hello"`))},
+		},
+		"key with json": {
+			mod:  "json",
+			data: "test:positive",
+			want: []weave.Model{weave.Pair([]byte("gconf:test:positive"), []byte(`3`))},
+		},
+		"missing": {
+			data: "test:missing",
+		},
+		"prefix": {
+			mod:  "prefix",
+			data: "test:",
+			want: []weave.Model{
+				weave.Pair([]byte("gconf:test:name"), []byte(`"hello"`)),
+				weave.Pair([]byte("gconf:test:positive"), []byte(`3`)),
+			},
+		},
+		"all": {
+			mod: "prefix",
+			want: []weave.Model{
+				weave.Pair([]byte("gconf:other:name"), []byte(`"world"`)),
+				weave.Pair([]byte("gconf:test:name"), []byte(`"hello"`)),
+				weave.Pair([]byte("gconf:test:positive"), []byte(`3`)),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			models, err := h.Query(db, tc.mod, []byte(tc.data))
+			if err != nil {
+				t.Fatalf("query failed: %s", err)
+			}
+			if len(models) != len(tc.want) {
+				t.Fatalf("want %d models, got %d", len(tc.want), len(models))
+			}
+			for i, m := range models {
+				if !bytes.Equal(m.Key, tc.want[i].Key) || !bytes.Equal(m.Value, tc.want[i].Value) {
+					t.Errorf("want %s=%s, got %s=%s", tc.want[i].Key, tc.want[i].Value, m.Key, m.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryDefault(t *testing.T) {
+	models, err := queryHandler{}.Query(store.MemStore(), weave.KeyQueryMod, []byte("other:name"))
+	if err != nil {
+		t.Fatalf("query failed: %s", err)
+	}
+	if len(models) != 1 || string(models[0].Value) != `"anonymous"` {
+		t.Fatalf("unexpected result: %v", models)
+	}
+}
+
+func TestQueryPrefixDefault(t *testing.T) {
+	db := store.MemStore()
+	if err := SetValue(db, "test:positive", 7); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+	models, err := queryHandler{}.Query(db, weave.PrefixQueryMod, nil)
+	if err != nil {
+		t.Fatalf("query failed: %s", err)
+	}
+	want := []weave.Model{
+		weave.Pair([]byte("gconf:other:name"), []byte(`"anonymous"`)),
+		weave.Pair([]byte("gconf:test:name"), []byte(`"anonymous"`)),
+		weave.Pair([]byte("gconf:test:positive"), []byte(`7`)),
+	}
+	if len(models) != len(want) {
+		t.Fatalf("want %d models, got %d", len(want), len(models))
+	}
+	for i, m := range models {
+		if !bytes.Equal(m.Key, want[i].Key) || !bytes.Equal(m.Value, want[i].Value) {
+			t.Errorf("want %s=%s, got %s=%s", want[i].Key, want[i].Value, m.Key, m.Value)
+		}
+	}
+}
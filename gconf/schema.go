@@ -0,0 +1,111 @@
+package gconf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Schema describes a configuration property, so that its value can be
+// checked before it is stored.
+type Schema struct {
+	// Type is a value of the type the property is decoded into,
+	// eg. x.Coin{} or weave.Address(nil). It can be omitted if
+	// Default is set.
+	Type interface{}
+	// Default is the value used when the property is not set in
+	// genesis. A property without a default is required.
+	Default interface{}
+	// Validate is optional. It is called with the decoded value
+	// (of the same type as Type) and rejects it by returning an error.
+	Validate func(value interface{}) error
+
+	// defaultRaw is the JSON encoded, validated default
+	defaultRaw []byte
+}
+
+var schemas = make(map[string]Schema)
+
+// Register declares the schema of a configuration property. Registered
+// properties are validated when loaded from genesis and only they can be
+// updated with UpdateConfigurationMsg.
+//
+// Extensions should register their properties during initialization.
+// This function panics if the property is already registered or the
+// default does not pass validation.
+func Register(propName string, s Schema) {
+	if _, ok := schemas[propName]; ok {
+		panic(fmt.Sprintf("configuration property %q already registered", propName))
+	}
+	if s.Type == nil {
+		s.Type = s.Default
+	}
+	if s.Type == nil {
+		panic(fmt.Sprintf("configuration property %q has no type", propName))
+	}
+	schemas[propName] = s
+
+	if s.Default != nil {
+		raw, err := json.Marshal(s.Default)
+		if err == nil {
+			raw, err = validateValue(propName, raw)
+		}
+		if err != nil {
+			delete(schemas, propName)
+			panic(fmt.Sprintf("invalid default of configuration property %q: %s", propName, err))
+		}
+		s.defaultRaw = raw
+		schemas[propName] = s
+	}
+}
+
+// defaultValue returns the JSON encoded default of the property,
+// or nil if it has none
+func defaultValue(propName string) []byte {
+	return schemas[propName].defaultRaw
+}
+
+// registeredProps returns the names of all registered properties,
+// in lexicographical order
+func registeredProps() []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateValue decodes the JSON encoded value of a property with its
+// schema and validates it. It returns the value encoded again, so that
+// the stored representation does not depend on the client.
+func validateValue(propName string, raw []byte) ([]byte, error) {
+	s, ok := schemas[propName]
+	if !ok {
+		return nil, ErrUnknownProperty(propName)
+	}
+
+	dest := reflect.New(reflect.TypeOf(s.Type))
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dest.Interface()); err != nil {
+		return nil, ErrInvalidValue(propName, err)
+	}
+	if dec.More() {
+		return nil, ErrInvalidValue(propName, fmt.Errorf("trailing data"))
+	}
+
+	value := dest.Elem().Interface()
+	if s.Validate != nil {
+		if err := s.Validate(value); err != nil {
+			return nil, ErrInvalidValue(propName, err)
+		}
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return nil, ErrInvalidValue(propName, err)
+	}
+	return normalized, nil
+}
@@ -17,6 +17,7 @@ package orm
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"sort"
 
@@ -44,10 +45,32 @@ type Bucket struct {
 	name   string
 	prefix []byte
 	proto  Cloneable
+	// valueType is the type of the values of proto, nil if unknown
+	valueType reflect.Type
 	// index is a list of indexes sorted by
 	indexes namedIndexes
+	// hooks are called on every change, in order of registration
+	hooks []ChangeHook
+	// refs are the references to objects in other buckets
+	refs []Reference
+	// referrers are the buckets referencing objects of this one
+	referrers []Referrer
+	// counter is the key of the number of stored objects,
+	// nil if not maintained
+	counter []byte
 }
 
+// ChangeHook observes modifications of the objects in a bucket.
+// prev is nil when an object is created and next is nil when it
+// is deleted.
+//
+// Hooks are called after the object and its indexes are written,
+// with the same store, so any data they write is part of the same
+// transaction. An error returned by a hook is returned by Save or
+// Delete, the caller must then discard the store (as it happens
+// to any failing transaction).
+type ChangeHook func(db weave.KVStore, prev, next Object) error
+
 var _ weave.QueryHandler = Bucket{}
 
 type namedIndex struct {
@@ -78,11 +101,22 @@ func NewBucket(name string, proto Cloneable) Bucket {
 		panic(fmt.Sprintf("Illegal bucket: %s", name))
 	}
 
-	return Bucket{
+	b := Bucket{
 		name:   name,
 		prefix: append([]byte(name), ':'),
 		proto:  proto,
 	}
+	if proto != nil {
+		if obj := proto.Clone(); obj != nil {
+			b.valueType = reflect.TypeOf(obj.Value())
+		}
+	}
+	return b
+}
+
+// Name returns the name of the bucket, used to prefix all keys
+func (b Bucket) Name() string {
+	return b.name
 }
 
 // Register registers this Bucket and all indexes.
@@ -95,7 +129,7 @@ func (b Bucket) Register(name string, r weave.QueryRouter) {
 	root := "/" + name
 	r.Register(root, b)
 	for _, ni := range b.indexes {
-		r.Register(root+"/"+ni.publicName, ni.Index)
+		r.Register(root+"/"+ni.publicName, indexQuery{index: ni.Index, bucket: b})
 	}
 }
 
@@ -103,6 +137,17 @@ func (b Bucket) Register(name string, r weave.QueryRouter) {
 func (b Bucket) Query(db weave.ReadOnlyKVStore, mod string,
 	data []byte) ([]weave.Model, error) {
 
+	return b.withJSON(mod, func(mod string) ([]weave.Model, error) {
+		return b.query(db, mod, data)
+	})
+}
+
+func (b Bucket) query(db weave.ReadOnlyKVStore, mod string,
+	data []byte) ([]weave.Model, error) {
+
+	if mod, ok := weave.ParseCountMod(mod); ok {
+		return b.queryCount(db, mod, data)
+	}
 	switch mod {
 	case weave.KeyQueryMod:
 		key := b.DBKey(data)
@@ -168,6 +213,9 @@ func (b Bucket) Parse(key, value []byte) (Object, error) {
 
 // Save will write a model, it must be of the same type as proto
 func (b Bucket) Save(db weave.KVStore, model Object) error {
+	if b.valueType != nil && reflect.TypeOf(model.Value()) != b.valueType {
+		return ErrInvalidObject(model.Value())
+	}
 	err := model.Validate()
 	if err != nil {
 		return err
@@ -177,41 +225,79 @@ func (b Bucket) Save(db weave.KVStore, model Object) error {
 	if err != nil {
 		return err
 	}
-	err = b.updateIndexes(db, model.Key(), model)
+	if err := b.checkReferences(db, model); err != nil {
+		return err
+	}
+	prev, err := b.loadPrev(db, model.Key())
+	if err != nil {
+		return err
+	}
+	err = b.updateIndexes(db, prev, model)
 	if err != nil {
 		return err
 	}
 
+	if err := b.updateCounter(db, prev, model); err != nil {
+		return err
+	}
+
 	// now save this one
 	db.Set(b.DBKey(model.Key()), bz)
-	return nil
+	return b.callHooks(db, prev, model)
 }
 
 // Delete will remove the value at a key
 func (b Bucket) Delete(db weave.KVStore, key []byte) error {
-	err := b.updateIndexes(db, key, nil)
+	if err := b.releaseReferrers(db, key); err != nil {
+		return err
+	}
+	prev, err := b.loadPrev(db, key)
+	if err != nil {
+		return err
+	}
+	err = b.updateIndexes(db, prev, nil)
 	if err != nil {
 		return err
 	}
 
+	if err := b.updateCounter(db, prev, nil); err != nil {
+		return err
+	}
+
 	// now save this one
 	dbkey := b.DBKey(key)
 	db.Delete(dbkey)
-	return nil
+	return b.callHooks(db, prev, nil)
+}
+
+// loadPrev returns the currently stored object, if anything
+// needs to know about it
+func (b Bucket) loadPrev(db weave.KVStore, key []byte) (Object, error) {
+	if len(b.indexes) == 0 && len(b.hooks) == 0 && b.counter == nil {
+		return nil, nil
+	}
+	return b.Get(db, key)
 }
 
-func (b Bucket) updateIndexes(db weave.KVStore, key []byte, model Object) error {
+func (b Bucket) updateIndexes(db weave.KVStore, prev, model Object) error {
 	// update all indexes
-	if len(b.indexes) > 0 {
-		prev, err := b.Get(db, key)
+	for _, idx := range b.indexes {
+		err := idx.Update(db, prev, model)
 		if err != nil {
 			return err
 		}
-		for _, idx := range b.indexes {
-			err = idx.Update(db, prev, model)
-			if err != nil {
-				return err
-			}
+	}
+	return nil
+}
+
+func (b Bucket) callHooks(db weave.KVStore, prev, next Object) error {
+	// deleting a missing object changes nothing
+	if prev == nil && next == nil {
+		return nil
+	}
+	for _, hook := range b.hooks {
+		if err := hook(db, prev, next); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -230,6 +316,25 @@ func (b Bucket) WithIndex(name string, indexer Indexer, unique bool) Bucket {
 	return b.WithMultiKeyIndex(name, asMultiKeyIndexer(indexer), unique)
 }
 
+// WithChangeHook returns a copy of this bucket that calls the hook
+// on every Save and Delete, see ChangeHook.
+//
+// Designed to be chained.
+func (b Bucket) WithChangeHook(hook ChangeHook) Bucket {
+	hooks := make([]ChangeHook, len(b.hooks), len(b.hooks)+1)
+	copy(hooks, b.hooks)
+	b.hooks = append(hooks, hook)
+	return b
+}
+
+// WithCompoundIndex registers an index over several fields of the
+// object. The index can be queried by all fields, or by any leading
+// subset of them using the prefix query modifier, with a key built
+// by CompoundKey.
+func (b Bucket) WithCompoundIndex(name string, indexer CompoundIndexer, unique bool) Bucket {
+	return b.WithMultiKeyIndex(name, asCompoundIndexer(indexer), unique)
+}
+
 func (b Bucket) WithMultiKeyIndex(name string, indexer MultiKeyIndexer, unique bool) Bucket {
 	// no duplicate indexes! (panic on init)
 	if b.indexes.Has(name) {
@@ -270,6 +375,22 @@ func (b Bucket) GetIndexedLike(db weave.ReadOnlyKVStore, name string, pattern Ob
 	return b.readRefs(db, refs)
 }
 
+// GetIndexedRange queries the named index for all objects with an
+// index value in the range [start, end). See Index.GetRange for details.
+func (b Bucket) GetIndexedRange(db weave.ReadOnlyKVStore, name string,
+	start, end []byte, limit int, reverse bool) ([]Object, error) {
+
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return nil, ErrInvalidIndex(name)
+	}
+	refs, err := idx.GetRange(db, start, end, limit, reverse)
+	if err != nil {
+		return nil, err
+	}
+	return b.readRefs(db, refs)
+}
+
 func (b Bucket) readRefs(db weave.ReadOnlyKVStore, refs [][]byte) ([]Object, error) {
 	if len(refs) == 0 {
 		return nil, nil
@@ -120,6 +120,18 @@ func TestBucketStore(t *testing.T) {
 }
 
 // make sure we have independent sequences
+func TestBucketSaveType(t *testing.T) {
+	db := store.MemStore()
+	bucket := NewBucket("cnts", NewSimpleObj(nil, new(Counter)))
+
+	err := bucket.Save(db, NewSimpleObj([]byte("a"), new(MultiRef)))
+	assert.True(t, IsInvalidObjectErr(err), "%v", err)
+	obj, err := bucket.Get(db, []byte("a"))
+	require.NoError(t, err)
+	assert.Nil(t, obj)
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+}
+
 func TestBucketSequence(t *testing.T) {
 	// make some buckets for testing
 	counter := NewSimpleObj(nil, new(Counter))
@@ -512,3 +524,60 @@ func sameOps(a []store.Op, b []store.Op) error {
 	}
 	return nil
 }
+
+func TestBucketChangeHook(t *testing.T) {
+	type change struct{ prev, next int64 }
+	var changes []change
+	count := func(obj Object) int64 {
+		if obj == nil {
+			return -1
+		}
+		return obj.Value().(*Counter).Count
+	}
+	// keep the sum of all counters in a separate key
+	sumKey := []byte("sum")
+	sum := func(db weave.KVStore, prev, next Object) error {
+		changes = append(changes, change{count(prev), count(next)})
+		var total int64
+		if raw := db.Get(sumKey); raw != nil {
+			total = int64(raw[0])
+		}
+		if prev != nil {
+			total -= count(prev)
+		}
+		if next != nil {
+			total += count(next)
+		}
+		if total > 100 {
+			return errors.New("sum too big")
+		}
+		db.Set(sumKey, []byte{byte(total)})
+		return nil
+	}
+
+	bucket := NewBucket("hooked", NewSimpleObj(nil, new(Counter))).
+		WithChangeHook(sum)
+	db := store.MemStore()
+
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(5))))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("b"), NewCounter(7))))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(10))))
+	require.NoError(t, bucket.Delete(db, []byte("b")))
+	// deleting a missing object is not a change
+	require.NoError(t, bucket.Delete(db, []byte("missing")))
+
+	assert.Equal(t, []change{{-1, 5}, {-1, 7}, {5, 10}, {7, -1}}, changes)
+	assert.Equal(t, []byte{10}, db.Get(sumKey))
+
+	// a failing hook fails the modification
+	err := bucket.Save(db, NewSimpleObj([]byte("c"), NewCounter(99)))
+	assert.EqualError(t, err, "sum too big")
+
+	// the original bucket is not modified when adding hooks
+	changes = nil
+	other := bucket.WithChangeHook(sum)
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("d"), NewCounter(1))))
+	assert.Len(t, changes, 1)
+	require.NoError(t, other.Save(db, NewSimpleObj([]byte("e"), NewCounter(1))))
+	assert.Len(t, changes, 3)
+}
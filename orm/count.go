@@ -0,0 +1,170 @@
+package orm
+
+import (
+	"strconv"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// WithCounter maintains the number of objects stored in the bucket,
+// so that counting all of them (a count query with an empty prefix)
+// is a single read instead of a scan.
+//
+// The counter is only updated by Save and Delete. Until it is first
+// written, the objects are counted with a scan, so it can be enabled
+// for a bucket that already holds data. A migration calling
+// RepairCounter (see CounterMigration) avoids the scans.
+func (b Bucket) WithCounter() Bucket {
+	b.counter = []byte("_c." + b.name + ":")
+	return b
+}
+
+// Count returns the number of objects in the bucket whose key
+// starts with the given prefix. An empty prefix counts all objects.
+func (b Bucket) Count(db weave.ReadOnlyKVStore, prefix []byte) (int64, error) {
+	if len(prefix) == 0 && b.counter != nil {
+		return b.storedCount(db)
+	}
+	return countKeys(db, b.DBKey(prefix)), nil
+}
+
+// RepairCounter recalculates the counter of the bucket from the
+// stored objects and overwrites it. It returns the new count.
+//
+// As this modifies the state, on a running chain it must only be
+// called in a way every node executes (eg. a migration).
+func (b Bucket) RepairCounter(db weave.KVStore) (int64, error) {
+	if b.counter == nil {
+		return 0, ErrNoCounter(b.name)
+	}
+	n := countKeys(db, b.prefix)
+	db.Set(b.counter, Int64Key(n))
+	return n, nil
+}
+
+// storedCount reads the counter maintained by WithCounter,
+// objects are counted if it was never written
+func (b Bucket) storedCount(db weave.ReadOnlyKVStore) (int64, error) {
+	raw := db.Get(b.counter)
+	if raw == nil {
+		return countKeys(db, b.prefix), nil
+	}
+	return ParseInt64Key(raw)
+}
+
+// updateCounter accounts for a created or deleted object,
+// if the bucket maintains a counter
+func (b Bucket) updateCounter(db weave.KVStore, prev, next Object) error {
+	var diff int64
+	switch {
+	case b.counter == nil:
+		return nil
+	case prev == nil && next != nil:
+		diff = 1
+	case prev != nil && next == nil:
+		diff = -1
+	default:
+		return nil
+	}
+	n, err := b.storedCount(db)
+	if err != nil {
+		return err
+	}
+	db.Set(b.counter, Int64Key(n+diff))
+	return nil
+}
+
+// queryCount serves the count modifier of a bucket query
+func (b Bucket) queryCount(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	var (
+		n   int64
+		err error
+	)
+	switch mod {
+	case weave.KeyQueryMod:
+		if db.Has(b.DBKey(data)) {
+			n = 1
+		}
+	case weave.PrefixQueryMod:
+		n, err = b.Count(db, data)
+	default:
+		return nil, errors.UnknownRequestErr.New("not implemented: " + mod)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []weave.Model{countModel(b.DBKey(data), n)}, nil
+}
+
+// Count returns the number of objects referenced by the given
+// index value. This only reads a single entry of the index.
+func (i Index) Count(db weave.ReadOnlyKVStore, index []byte) (int64, error) {
+	refs, err := i.GetAt(db, index)
+	return int64(len(refs)), err
+}
+
+// CountPrefix returns the number of objects referenced by all
+// index values starting with the given prefix
+func (i Index) CountPrefix(db weave.ReadOnlyKVStore, prefix []byte) (int64, error) {
+	itr := db.Iterator(prefixRange(i.IndexKey(prefix)))
+	defer itr.Close()
+
+	var n int64
+	for ; itr.Valid(); itr.Next() {
+		refs, err := i.parseRefs(itr.Value())
+		if err != nil {
+			return 0, err
+		}
+		n += int64(len(refs))
+	}
+	return n, nil
+}
+
+// queryCount serves the count modifier of an index query
+func (i Index) queryCount(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	var (
+		n   int64
+		err error
+	)
+	switch mod {
+	case weave.KeyQueryMod:
+		n, err = i.Count(db, data)
+	case weave.PrefixQueryMod:
+		n, err = i.CountPrefix(db, data)
+	default:
+		return nil, errors.UnknownRequestErr.New("not implemented: " + mod)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []weave.Model{countModel(i.IndexKey(data), n)}, nil
+}
+
+// countModel is the result of a count query. The key is the queried
+// key or prefix in the db and the value the count, encoded with
+// Int64Key (or as a JSON number with the json modifier).
+func countModel(key []byte, n int64) weave.Model {
+	return weave.Model{Key: key, Value: Int64Key(n)}
+}
+
+// countJSON renders the value of a count query as a JSON number
+func countJSON(value []byte) ([]byte, error) {
+	n, err := ParseInt64Key(value)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// countKeys counts all keys in the db starting with prefix
+func countKeys(db weave.ReadOnlyKVStore, prefix []byte) int64 {
+	itr := db.Iterator(prefixRange(prefix))
+	defer itr.Close()
+
+	var n int64
+	for ; itr.Valid(); itr.Next() {
+		n++
+	}
+	return n
+}
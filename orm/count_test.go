@@ -0,0 +1,122 @@
+package orm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketCounter(t *testing.T) {
+	bucket := NewBucket("cnt", NewSimpleObj(nil, new(Counter))).WithCounter()
+	db := store.MemStore()
+
+	assertCount := func(want int64) {
+		t.Helper()
+		n, err := bucket.Count(db, nil)
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+	}
+
+	assertCount(0)
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+	assertCount(2)
+
+	// updates and deleting missing objects do not change the count
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(3))))
+	require.NoError(t, bucket.Delete(db, []byte("c")))
+	assertCount(2)
+
+	require.NoError(t, bucket.Delete(db, []byte("a")))
+	assertCount(1)
+
+	// data written before the counter was enabled is fixed by a repair
+	plain := NewBucket("cnt", NewSimpleObj(nil, new(Counter)))
+	require.NoError(t, plain.Save(db, NewSimpleObj([]byte("d"), NewCounter(4))))
+	assertCount(1)
+	n, err := bucket.RepairCounter(db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assertCount(2)
+
+	_, err = plain.RepairCounter(db)
+	assert.True(t, IsProgammerErr(err))
+}
+
+func TestCounterEnabledLater(t *testing.T) {
+	db := store.MemStore()
+	plain := NewBucket("cntl", NewSimpleObj(nil, new(Counter)))
+	require.NoError(t, plain.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	require.NoError(t, plain.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+
+	bucket := plain.WithCounter()
+	n, err := bucket.Count(db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	// the first change writes the counter from the existing objects
+	require.NoError(t, bucket.Delete(db, []byte("a")))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("c"), NewCounter(3))))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("d"), NewCounter(4))))
+	n, err = bucket.Count(db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	_, err = Migrations{CounterMigration("cntl_counter", bucket)}.Tick(context.Background(), db)
+	require.NoError(t, err)
+	n, err = bucket.Count(db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
+
+func TestQueryCount(t *testing.T) {
+	bucket := NewBucket("cntq", NewSimpleObj(nil, new(Counter))).
+		WithIndex("count", countByte, false)
+	counted := NewBucket("cntc", NewSimpleObj(nil, new(Counter))).WithCounter()
+
+	db := store.MemStore()
+	for i, key := range []string{"aa", "ab", "b"} {
+		obj := NewSimpleObj([]byte(key), NewCounter(int64(i%2+1)))
+		require.NoError(t, bucket.Save(db, obj))
+		require.NoError(t, counted.Save(db, obj))
+	}
+
+	qr := weave.NewQueryRouter()
+	bucket.Register("", qr)
+	counted.Register("", qr)
+
+	cases := map[string]struct {
+		path, mod string
+		data      []byte
+		want      int64
+		wantJSON  string
+	}{
+		"key":              {path: "/cntq", mod: "count", data: []byte("aa"), want: 1},
+		"missing key":      {path: "/cntq", mod: "count", data: []byte("c"), want: 0},
+		"prefix":           {path: "/cntq", mod: "prefix,count", data: []byte("a"), want: 2},
+		"all":              {path: "/cntq", mod: "prefix,count", want: 3},
+		"all with counter": {path: "/cntc", mod: "prefix,count", want: 3},
+		"index":            {path: "/cntq/count", mod: "count", data: bc(1), want: 2},
+		"index prefix":     {path: "/cntq/count", mod: "prefix,count", want: 3},
+		"json":             {path: "/cntq/count", mod: "count,json", data: bc(2), wantJSON: "1"},
+		"prefix json":      {path: "/cntq", mod: "prefix,count,json", wantJSON: "3"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			models, err := qr.Handler(tc.path).Query(db, tc.mod, tc.data)
+			require.NoError(t, err)
+			require.Len(t, models, 1)
+			if tc.wantJSON != "" {
+				assert.Equal(t, tc.wantJSON, string(models[0].Value))
+				return
+			}
+			n, err := ParseInt64Key(models[0].Value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, n)
+		})
+	}
+}
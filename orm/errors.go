@@ -15,82 +15,100 @@ const (
 	CodeInvalidModification = 13
 	CodeInvalidObject       = 14
 	CodeProgrammer          = 15
+	CodeDanglingReference   = 16
+	CodeReferenced          = 17
 )
 
 var (
-	// errInsufficientFees  = fmt.Errorf("Insufficient fees")
-	// errInsufficientFunds = fmt.Errorf("Insufficient funds")
-	errInvalidIndex  = fmt.Errorf("No such index")
-	errInvalidObject = fmt.Errorf("Wrong object type for this bucket")
+	codes = errors.RegisterRange("orm", 10, 19)
 
-	errUniqueConstraint = fmt.Errorf("Duplicate violates unique constraint on index")
-	errRefInSet         = fmt.Errorf("Ref already in set")
-
-	errMissingKey         = fmt.Errorf("Missing key")
-	errMissingValue       = fmt.Errorf("Missing value")
-	errNoRefs             = fmt.Errorf("No references")
-	errRemoveUnregistered = fmt.Errorf("Cannot remove index to something that was not added")
-
-	errModifiedPK = fmt.Errorf("Cannot modify the primary key of an object")
-
-	errUpdateNil = fmt.Errorf("update requires at least one non-nil object")
-	errBoolean   = fmt.Errorf("You have violated the rules of boolean logic")
+	InvalidIndexErr        = codes.Register(CodeInvalidIndex, "invalid index")
+	DuplicateErr           = codes.Register(CodeDuplicate, "duplicate")
+	MissingErr             = codes.Register(CodeMissing, "missing")
+	InvalidModificationErr = codes.Register(CodeInvalidModification, "invalid modification")
+	InvalidObjectErr       = codes.Register(CodeInvalidObject, "invalid object")
+	ProgrammerErr          = codes.Register(CodeProgrammer, "programmer error")
+	DanglingReferenceErr   = codes.Register(CodeDanglingReference, "dangling reference")
+	ReferencedErr          = codes.Register(CodeReferenced, "referenced")
 )
 
 func ErrInvalidObject(obj interface{}) error {
-	msg := fmt.Sprintf("%T", obj)
-	return errors.WithLog(msg, errInvalidObject, CodeInvalidObject)
+	return InvalidObjectErr.New(fmt.Sprintf("%T", obj))
+}
+func IsInvalidObjectErr(err error) bool {
+	return errors.HasErrorCode(err, CodeInvalidObject)
 }
 
 func ErrInvalidIndex(reason string) error {
-	return errors.WithLog(reason, errInvalidIndex, CodeInvalidIndex)
+	return InvalidIndexErr.New(reason)
 }
 func IsInvalidIndexErr(err error) bool {
-	return errors.IsSameError(errInvalidIndex, err)
+	return errors.HasErrorCode(err, CodeInvalidIndex)
 }
 
+// ErrUniqueConstraint and ErrRefInSet are both duplicates,
+// the Is functions match any duplicate error
 func ErrUniqueConstraint(reason string) error {
-	return errors.WithLog(reason, errUniqueConstraint, CodeDuplicate)
+	return DuplicateErr.New("unique constraint violated: " + reason)
 }
 func IsUniqueConstraintErr(err error) bool {
-	return errors.IsSameError(errUniqueConstraint, err)
+	return errors.HasErrorCode(err, CodeDuplicate)
 }
 func ErrRefInSet() error {
-	return errors.WithCode(errRefInSet, CodeDuplicate)
+	return DuplicateErr.New("ref already in set")
 }
 func IsRefInSetErr(err error) bool {
-	return errors.IsSameError(errRefInSet, err)
+	return errors.HasErrorCode(err, CodeDuplicate)
 }
 
 func IsMissingErr(err error) bool {
 	return errors.HasErrorCode(err, CodeMissing)
 }
 func ErrMissingKey() error {
-	return errors.WithCode(errMissingKey, CodeMissing)
+	return MissingErr.New("key")
 }
 func ErrMissingValue() error {
-	return errors.WithCode(errMissingValue, CodeMissing)
+	return MissingErr.New("value")
 }
 func ErrNoRefs() error {
-	return errors.WithCode(errNoRefs, CodeMissing)
+	return MissingErr.New("no references")
 }
 func ErrRemoveUnregistered() error {
-	return errors.WithCode(errRemoveUnregistered, CodeMissing)
+	return MissingErr.New("cannot remove index to something that was not added")
 }
 
 func IsInvalidModificationErr(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidModification)
 }
 func ErrModifiedPK() error {
-	return errors.WithCode(errModifiedPK, CodeInvalidModification)
+	return InvalidModificationErr.New("cannot modify the primary key of an object")
 }
 
 func IsProgammerErr(err error) bool {
 	return errors.HasErrorCode(err, CodeProgrammer)
 }
 func ErrUpdateNil() error {
-	return errors.WithCode(errUpdateNil, CodeProgrammer)
+	return ProgrammerErr.New("update requires at least one non-nil object")
 }
 func ErrBoolean() error {
-	return errors.WithCode(errBoolean, CodeProgrammer)
+	return ProgrammerErr.New("you have violated the rules of boolean logic")
+}
+func ErrUnversioned() error {
+	return ProgrammerErr.New("versioned bucket requires a height")
+}
+func ErrNoCounter(bucket string) error {
+	return ProgrammerErr.New("bucket does not maintain a counter: " + bucket)
+}
+
+func ErrDanglingReference(reason string) error {
+	return DanglingReferenceErr.New(reason)
+}
+func IsDanglingReferenceErr(err error) bool {
+	return errors.HasErrorCode(err, CodeDanglingReference)
+}
+func ErrReferenced(reason string) error {
+	return ReferencedErr.New(reason)
+}
+func IsReferencedErr(err error) bool {
+	return errors.HasErrorCode(err, CodeReferenced)
 }
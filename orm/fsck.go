@@ -0,0 +1,202 @@
+package orm
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iov-one/weave"
+)
+
+// IndexMismatch describes a single index entry that does not match
+// the primary data stored in the bucket
+type IndexMismatch struct {
+	// Bucket is the name of the bucket
+	Bucket string
+	// Index is the public name of the index, as used in WithIndex
+	Index string
+	// Key is the index value (without the index prefix)
+	Key []byte
+	// Want is the sorted list of primary keys calculated
+	// from the objects stored in the bucket
+	Want [][]byte
+	// Got is the sorted list of primary keys stored in the index
+	Got [][]byte
+}
+
+// CheckIndexes rebuilds all indexes of the bucket from the primary
+// data, using the registered indexers, and compares them with the
+// stored ones. It returns all entries that differ.
+//
+// This is an expensive operation, as it reads the whole bucket.
+// It is meant to be used by offline tools and migrations.
+func (b Bucket) CheckIndexes(db weave.ReadOnlyKVStore) ([]IndexMismatch, error) {
+	var res []IndexMismatch
+	for _, ni := range b.indexes {
+		want, err := b.rebuildIndex(db, ni.Index)
+		if err != nil {
+			return nil, err
+		}
+		got, err := ni.Index.storedRefs(db)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range unionKeys(want, got) {
+			w, g := want[key], got[key]
+			if equalRefs(w, g) {
+				continue
+			}
+			res = append(res, IndexMismatch{
+				Bucket: b.name,
+				Index:  ni.publicName,
+				Key:    []byte(key),
+				Want:   w,
+				Got:    g,
+			})
+		}
+	}
+	return res, nil
+}
+
+// RepairIndexes finds all index entries that differ from the primary
+// data (see CheckIndexes) and overwrites them with the rebuilt ones.
+// It returns all entries that were modified.
+//
+// A unique index cannot be repaired when several objects share the
+// same index value, this returns ErrUniqueConstraint.
+//
+// As this modifies the state, on a running chain it must only be
+// called in a way every node executes (eg. a migration), never
+// on the data of a single node.
+func (b Bucket) RepairIndexes(db weave.KVStore) ([]IndexMismatch, error) {
+	mismatches, err := b.CheckIndexes(db)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mismatches {
+		idx := b.indexes.Get(m.Index)
+		if err := idx.overwrite(db, m.Key, m.Want); err != nil {
+			return nil, err
+		}
+	}
+	return mismatches, nil
+}
+
+// rebuildIndex calculates the content of the index from all
+// objects stored in the bucket
+func (b Bucket) rebuildIndex(db weave.ReadOnlyKVStore, idx Index) (map[string][][]byte, error) {
+	res := make(map[string][][]byte)
+	err := b.Iterate(db, nil, nil, func(obj Object) error {
+		keys, err := idx.index(obj)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			// empty keys are never indexed
+			if len(key) == 0 {
+				continue
+			}
+			res[string(key)] = append(res[string(key)], obj.Key())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for k, refs := range res {
+		res[k] = sortRefs(deduplicate(refs))
+	}
+	return res, nil
+}
+
+// storedRefs reads the content of the index as stored in the db
+func (i Index) storedRefs(db weave.ReadOnlyKVStore) (map[string][][]byte, error) {
+	res := make(map[string][][]byte)
+	itr := db.Iterator(prefixRange(i.id))
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key := string(itr.Key()[len(i.id):])
+		if i.unique {
+			res[key] = [][]byte{append([]byte(nil), itr.Value()...)}
+			continue
+		}
+		var data MultiRef
+		if err := data.Unmarshal(itr.Value()); err != nil {
+			return nil, err
+		}
+		res[key] = sortRefs(data.GetRefs())
+	}
+	return res, nil
+}
+
+// overwrite replaces the stored index entry with the given references
+func (i Index) overwrite(db weave.KVStore, index []byte, refs [][]byte) error {
+	key := i.IndexKey(index)
+	switch {
+	case len(refs) == 0:
+		db.Delete(key)
+	case i.unique && len(refs) > 1:
+		return ErrUniqueConstraint(i.name)
+	case i.unique:
+		db.Set(key, refs[0])
+	default:
+		raw, err := (&MultiRef{Refs: refs}).Marshal()
+		if err != nil {
+			return err
+		}
+		db.Set(key, raw)
+	}
+	return nil
+}
+
+// BucketsFromRouter returns all buckets registered in the query
+// router, sorted by name. This is handy to check all buckets
+// of an application.
+func BucketsFromRouter(qr weave.QueryRouter) []Bucket {
+	seen := make(map[string]bool)
+	var res []Bucket
+	for _, h := range qr.Routes() {
+		b, ok := h.(Bucket)
+		// the root query handler is not a real bucket
+		if !ok || b.proto == nil || seen[b.name] {
+			continue
+		}
+		seen[b.name] = true
+		res = append(res, b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].name < res[j].name })
+	return res
+}
+
+func unionKeys(a, b map[string][][]byte) []string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortRefs(refs [][]byte) [][]byte {
+	sort.Slice(refs, func(i, j int) bool {
+		return bytes.Compare(refs[i], refs[j]) < 0
+	})
+	return refs
+}
+
+func equalRefs(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
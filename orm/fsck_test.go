@@ -0,0 +1,87 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAndRepairIndexes(t *testing.T) {
+	const uniq, mini = "uniq", "mini"
+	bucket := NewBucket("fsck", NewSimpleObj(nil, new(Counter))).
+		WithIndex(uniq, count, true).
+		WithIndex(mini, countByte, false)
+
+	db := store.MemStore()
+	for i, n := range []int64{5, 256 + 5, 7} {
+		obj := NewSimpleObj([]byte{'a' + byte(i)}, NewCounter(n))
+		require.NoError(t, bucket.Save(db, obj))
+	}
+
+	mismatches, err := bucket.CheckIndexes(db)
+	require.NoError(t, err)
+	assert.Len(t, mismatches, 0)
+
+	// corrupt the state: delete a primary key without updating
+	// indexes, add an index entry pointing nowhere
+	db.Delete(bucket.DBKey([]byte("b")))
+	idx := bucket.indexes.Get(uniq)
+	db.Set(idx.IndexKey(bc(99)), []byte("zzz"))
+
+	mismatches, err = bucket.CheckIndexes(db)
+	require.NoError(t, err)
+	want := []IndexMismatch{
+		{
+			Bucket: "fsck",
+			Index:  mini,
+			Key:    bc(5),
+			Want:   [][]byte{[]byte("a")},
+			Got:    [][]byte{[]byte("a"), []byte("b")},
+		},
+		{
+			Bucket: "fsck",
+			Index:  uniq,
+			Key:    bc(99),
+			Got:    [][]byte{[]byte("zzz")},
+		},
+		{
+			Bucket: "fsck",
+			Index:  uniq,
+			Key:    encodeSequence(256 + 5),
+			Got:    [][]byte{[]byte("b")},
+		},
+	}
+	assert.ElementsMatch(t, want, mismatches)
+
+	repaired, err := bucket.RepairIndexes(db)
+	require.NoError(t, err)
+	assert.Len(t, repaired, len(mismatches))
+
+	mismatches, err = bucket.CheckIndexes(db)
+	require.NoError(t, err)
+	assert.Len(t, mismatches, 0)
+
+	res, err := bucket.GetIndexed(db, mini, bc(5))
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, []byte("a"), res[0].Key())
+}
+
+func TestBucketsFromRouter(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	NewBucket("foo", NewSimpleObj(nil, new(Counter))).
+		WithIndex("cnt", countByte, false).
+		Register("", qr)
+	NewBucket("bar", NewSimpleObj(nil, new(Counter))).Register("", qr)
+	// same bucket registered twice is only returned once
+	NewBucket("bar", NewSimpleObj(nil, new(Counter))).Register("other", qr)
+
+	buckets := BucketsFromRouter(qr)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, "bar", buckets[0].Name())
+	assert.Equal(t, "foo", buckets[1].Name())
+}
@@ -0,0 +1,186 @@
+package orm
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// histPrefix is prepended to the name of a bucket to store
+// the versions of its objects
+var histPrefix = []byte("_h.")
+
+// deletedVersion marks the deletion of an object in its history.
+// Field number 0 is not valid in protobuf, so this is never
+// the encoding of a stored object.
+var deletedVersion = []byte{0}
+
+// VersionedBucket is a bucket that keeps every version of its
+// objects, keyed by (primary key, height), so that its state at
+// any height can be loaded long after the iavl versions are pruned.
+//
+// The history is written by SaveAt and DeleteAt. Save and Delete
+// use the height known by the store (see store.WithHeight), which
+// is the case of the stores given to handlers, tickers and
+// initializers by the application, and fail with ErrUnversioned
+// on a store that does not know the height.
+// Only the last version written at a given height is kept.
+type VersionedBucket struct {
+	Bucket
+	history []byte
+}
+
+// Version is the state of an object since the given height
+type Version struct {
+	Height int64
+	// Object is nil if the object was deleted at this height
+	Object Object
+}
+
+// WithHistory returns a versioned copy of this bucket.
+// It should be called after all indexes are registered.
+func (b Bucket) WithHistory() VersionedBucket {
+	return VersionedBucket{
+		Bucket:  b,
+		history: append(append(append([]byte(nil), histPrefix...), b.name...), ':'),
+	}
+}
+
+// Save calls SaveAt with the height known by the store
+func (b VersionedBucket) Save(db weave.KVStore, model Object) error {
+	height, ok := store.GetHeight(db)
+	if !ok {
+		return ErrUnversioned()
+	}
+	return b.SaveAt(db, height, model)
+}
+
+// Delete calls DeleteAt with the height known by the store
+func (b VersionedBucket) Delete(db weave.KVStore, key []byte) error {
+	height, ok := store.GetHeight(db)
+	if !ok {
+		return ErrUnversioned()
+	}
+	return b.DeleteAt(db, height, key)
+}
+
+// SaveAt writes the model, as Bucket.Save does, and records
+// it as the version of the object at the given height
+func (b VersionedBucket) SaveAt(db weave.KVStore, height int64, model Object) error {
+	if err := b.Bucket.Save(db, model); err != nil {
+		return err
+	}
+	bz, err := model.Value().Marshal()
+	if err != nil {
+		return err
+	}
+	db.Set(b.versionKey(model.Key(), height), bz)
+	return nil
+}
+
+// DeleteAt removes the object, as Bucket.Delete does, and records
+// the deletion at the given height. Previous versions are kept.
+func (b VersionedBucket) DeleteAt(db weave.KVStore, height int64, key []byte) error {
+	if err := b.Bucket.Delete(db, key); err != nil {
+		return err
+	}
+	db.Set(b.versionKey(key, height), deletedVersion)
+	return nil
+}
+
+// History returns all versions of the object with the given key,
+// ordered by height
+func (b VersionedBucket) History(db weave.ReadOnlyKVStore, key []byte) ([]Version, error) {
+	itr := db.Iterator(prefixRange(b.versionPrefix(key)))
+	defer itr.Close()
+
+	var res []Version
+	for ; itr.Valid(); itr.Next() {
+		v, err := b.parseVersion(key, itr.Key(), itr.Value())
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// GetAt returns the object with the given key as it was at the end
+// of the given height, or nil if it did not exist then
+func (b VersionedBucket) GetAt(db weave.ReadOnlyKVStore, key []byte, height int64) (Object, error) {
+	start, _ := prefixRange(b.versionPrefix(key))
+	itr := db.ReverseIterator(start, b.versionKey(key, height+1))
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil, nil
+	}
+	v, err := b.parseVersion(key, itr.Key(), itr.Value())
+	if err != nil {
+		return nil, err
+	}
+	return v.Object, nil
+}
+
+// Register registers the bucket with all indexes, as Bucket.Register
+// does, and the history of the objects under "/<name>/history"
+func (b VersionedBucket) Register(name string, r weave.QueryRouter) {
+	if name == "" {
+		name = b.name
+	}
+	b.Bucket.Register(name, r)
+	r.Register("/"+name+"/history", historyQuery{b})
+}
+
+// versionPrefix is the common prefix of all versions of an object
+func (b VersionedBucket) versionPrefix(key []byte) []byte {
+	return append(append([]byte(nil), b.history...), CompoundKey(key)...)
+}
+
+func (b VersionedBucket) versionKey(key []byte, height int64) []byte {
+	return append(append([]byte(nil), b.history...), CompoundKey(key, Int64Key(height))...)
+}
+
+func (b VersionedBucket) parseVersion(key, dbkey, value []byte) (Version, error) {
+	segments, err := ParseCompoundKey(dbkey[len(b.history):])
+	if err != nil {
+		return Version{}, err
+	}
+	if len(segments) != 2 {
+		return Version{}, InvalidIndexErr.New("invalid version key")
+	}
+	height, err := ParseInt64Key(segments[1])
+	if err != nil {
+		return Version{}, err
+	}
+	if bytes.Equal(value, deletedVersion) {
+		return Version{Height: height}, nil
+	}
+	obj, err := b.Parse(append([]byte(nil), key...), value)
+	if err != nil {
+		return Version{}, err
+	}
+	return Version{Height: height, Object: obj}, nil
+}
+
+// historyQuery returns all versions of the object with the
+// primary key given as data, ordered by height. The key of each
+// result is the db key, ending with the height encoded with Int64Key.
+// The value of a deletion is a single zero byte.
+type historyQuery struct {
+	bucket VersionedBucket
+}
+
+var _ weave.QueryHandler = historyQuery{}
+
+func (h historyQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	return h.bucket.withJSON(mod, func(mod string) ([]weave.Model, error) {
+		if mod != weave.KeyQueryMod {
+			return nil, errors.UnknownRequestErr.New("not implemented: " + mod)
+		}
+		itr := db.Iterator(prefixRange(h.bucket.versionPrefix(data)))
+		return consumeIterator(itr), nil
+	})
+}
@@ -0,0 +1,77 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedBucket(t *testing.T) {
+	bucket := NewBucket("versioned", NewSimpleObj(nil, new(Counter))).
+		WithIndex("count", countByte, false).
+		WithHistory()
+	db := store.MemStore()
+
+	key := []byte("a")
+	// plain save and delete need a store knowing the height
+	assert.True(t, IsProgammerErr(bucket.Save(db, NewSimpleObj(key, NewCounter(1)))))
+	assert.True(t, IsProgammerErr(bucket.Delete(db, key)))
+
+	require.NoError(t, bucket.SaveAt(db, 2, NewSimpleObj(key, NewCounter(1))))
+	require.NoError(t, bucket.SaveAt(db, 5, NewSimpleObj(key, NewCounter(2))))
+	// only the last version of a height is kept
+	require.NoError(t, bucket.Save(store.WithHeight(db, 5), NewSimpleObj(key, NewCounter(3))))
+	// the height is known by cache wraps too
+	cache := store.WithHeight(db, 7).CacheWrap()
+	require.NoError(t, bucket.Delete(cache, key))
+	cache.Write()
+	require.NoError(t, bucket.SaveAt(db, 300, NewSimpleObj(key, NewCounter(4))))
+	// other objects do not show up in the history
+	require.NoError(t, bucket.SaveAt(db, 3, NewSimpleObj([]byte("ab"), NewCounter(9))))
+
+	obj, err := bucket.Get(db, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), obj.Value().(*Counter).Count)
+	// indexes are maintained as usual
+	objs, err := bucket.GetIndexed(db, "count", bc(4))
+	require.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	count := func(obj Object) int64 {
+		if obj == nil {
+			return 0
+		}
+		return obj.Value().(*Counter).Count
+	}
+
+	versions, err := bucket.History(db, key)
+	require.NoError(t, err)
+	var got [][2]int64
+	for _, v := range versions {
+		got = append(got, [2]int64{v.Height, count(v.Object)})
+	}
+	assert.Equal(t, [][2]int64{{2, 1}, {5, 3}, {7, 0}, {300, 4}}, got)
+
+	states := map[int64]int64{1: 0, 2: 1, 4: 1, 5: 3, 6: 3, 7: 0, 299: 0, 300: 4, 1000: 4}
+	for height, want := range states {
+		obj, err := bucket.GetAt(db, key, height)
+		require.NoError(t, err)
+		assert.Equal(t, want, count(obj), "height %d", height)
+	}
+
+	qr := weave.NewQueryRouter()
+	bucket.Register("", qr)
+	h := qr.Handler("/versioned/history")
+	require.NotNil(t, h)
+	models, err := h.Query(db, weave.KeyQueryMod, key)
+	require.NoError(t, err)
+	require.Len(t, models, 4)
+	assert.Equal(t, deletedVersion, models[2].Value)
+	assert.NotNil(t, qr.Handler("/versioned/count"))
+	_, err = h.Query(db, weave.PrefixQueryMod, key)
+	assert.True(t, errors.HasErrorCode(err, errors.CodeUnknownRequest))
+}
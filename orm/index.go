@@ -15,6 +15,12 @@ type Indexer func(Object) ([]byte, error)
 // MultiKeyIndexer calculates the secondary index keys for a given object
 type MultiKeyIndexer func(Object) ([][]byte, error)
 
+// CompoundIndexer calculates the values of all fields of a compound
+// index for a given object, in order. They are encoded into a single
+// index key with CompoundKey. Returning no fields skips indexing
+// the object.
+type CompoundIndexer func(Object) ([][]byte, error)
+
 // Index represents a secondary index on some data.
 // It is indexed by an arbitrary key returned by Indexer.
 // The value is one primary key (unique),
@@ -67,6 +73,19 @@ func asMultiKeyIndexer(indexer Indexer) MultiKeyIndexer {
 	}
 }
 
+func asCompoundIndexer(indexer CompoundIndexer) MultiKeyIndexer {
+	return func(obj Object) ([][]byte, error) {
+		fields, err := indexer(obj)
+		switch {
+		case err != nil:
+			return nil, err
+		case len(fields) == 0:
+			return nil, nil
+		}
+		return [][]byte{CompoundKey(fields...)}, nil
+	}
+}
+
 // IndexKey is the full key we store in the db, including prefix
 // We copy into a new array rather than use append, as we don't
 // want consequetive calls to overwrite the same byte array.
@@ -180,25 +199,89 @@ func (i Index) GetPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([][]byte, err
 	var data [][]byte
 
 	for ; itr.Valid(); itr.Next() {
-		if i.unique {
-			data = append(data, itr.Value())
-		} else {
-			tmp := new(MultiRef)
-			err := tmp.Unmarshal(itr.Value())
-			if err != nil {
-				return nil, err
-			}
-			data = append(data, tmp.Refs...)
+		refs, err := i.parseRefs(itr.Value())
+		if err != nil {
+			return nil, err
 		}
+		data = append(data, refs...)
+	}
+
+	return data, nil
+}
+
+// GetRange returns all references that have an index value in the
+// range [start, end), in the order of the index values. A nil start
+// or end means the range is not bounded on that side.
+//
+// At most limit references are returned, use 0 for no limit.
+// If reverse is true, the references are returned in descending order.
+//
+// Use an order-preserving encoding (eg. Int64Key) in the indexer
+// for range queries to make sense.
+func (i Index) GetRange(db weave.ReadOnlyKVStore, start, end []byte,
+	limit int, reverse bool) ([][]byte, error) {
+
+	from, to := prefixRange(i.id)
+	if start != nil {
+		from = i.IndexKey(start)
+	}
+	if end != nil {
+		to = i.IndexKey(end)
 	}
 
+	var itr weave.Iterator
+	if reverse {
+		itr = db.ReverseIterator(from, to)
+	} else {
+		itr = db.Iterator(from, to)
+	}
+	defer itr.Close()
+
+	var data [][]byte
+	for ; itr.Valid(); itr.Next() {
+		refs, err := i.parseRefs(itr.Value())
+		if err != nil {
+			return nil, err
+		}
+		if reverse {
+			reverseRefs(refs)
+		}
+		for _, ref := range refs {
+			data = append(data, ref)
+			if limit > 0 && len(data) == limit {
+				return data, nil
+			}
+		}
+	}
 	return data, nil
 }
 
+// parseRefs decodes a stored index value into the list
+// of primary keys it references
+func (i Index) parseRefs(value []byte) ([][]byte, error) {
+	if i.unique {
+		return [][]byte{value}, nil
+	}
+	var data = new(MultiRef)
+	if err := data.Unmarshal(value); err != nil {
+		return nil, err
+	}
+	return data.GetRefs(), nil
+}
+
+func reverseRefs(refs [][]byte) {
+	for l, r := 0, len(refs)-1; l < r; l, r = l+1, r-1 {
+		refs[l], refs[r] = refs[r], refs[l]
+	}
+}
+
 // Query handles queries from the QueryRouter
 func (i Index) Query(db weave.ReadOnlyKVStore, mod string,
 	data []byte) ([]weave.Model, error) {
 
+	if mod, ok := weave.ParseCountMod(mod); ok {
+		return i.queryCount(db, mod, data)
+	}
 	switch mod {
 	case weave.KeyQueryMod:
 		refs, err := i.GetAt(db, data)
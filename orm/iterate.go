@@ -0,0 +1,67 @@
+package orm
+
+import (
+	"errors"
+
+	"github.com/iov-one/weave"
+)
+
+// StopIteration can be returned by the callback passed to
+// Bucket.Iterate to stop the iteration early. It is never returned
+// by Iterate itself.
+var StopIteration = errors.New("stop iteration")
+
+// Iterate calls fn for every object stored in the bucket with
+// a primary key in the range [start, end), in ascending key order.
+// A nil start or end leaves that side of the range open.
+//
+// Objects are read and decoded one at a time while iterating, so
+// stopping early (see StopIteration) never touches the remaining
+// ones. Any other error returned by fn stops the iteration and
+// is returned.
+//
+// The store must not be modified from within fn.
+func (b Bucket) Iterate(db weave.ReadOnlyKVStore, start, end []byte, fn func(Object) error) error {
+	from, to := b.keyRange(start, end)
+	return b.iterate(db.Iterator(from, to), fn)
+}
+
+// ReverseIterate works like Iterate, but visits the objects
+// in descending key order.
+func (b Bucket) ReverseIterate(db weave.ReadOnlyKVStore, start, end []byte, fn func(Object) error) error {
+	from, to := b.keyRange(start, end)
+	return b.iterate(db.ReverseIterator(from, to), fn)
+}
+
+func (b Bucket) iterate(itr weave.Iterator, fn func(Object) error) error {
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key := append([]byte(nil), itr.Key()[len(b.prefix):]...)
+		obj, err := b.Parse(key, itr.Value())
+		if err != nil {
+			return err
+		}
+		switch err := fn(obj); err {
+		case nil:
+		case StopIteration:
+			return nil
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// keyRange returns the db keys limiting the given range
+// of primary keys, defaulting to the whole bucket
+func (b Bucket) keyRange(start, end []byte) ([]byte, []byte) {
+	from, to := prefixRange(b.prefix)
+	if start != nil {
+		from = b.DBKey(start)
+	}
+	if end != nil {
+		to = b.DBKey(end)
+	}
+	return from, to
+}
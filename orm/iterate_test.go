@@ -0,0 +1,76 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketIterate(t *testing.T) {
+	bucket := NewBucket("iter", NewSimpleObj(nil, new(Counter)))
+	// other buckets sharing the prefix must not be visited
+	other := NewBucket("itera", NewSimpleObj(nil, new(Counter)))
+
+	db := store.MemStore()
+	for i, key := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, bucket.Save(db, NewSimpleObj([]byte(key), NewCounter(int64(i+1)))))
+	}
+	require.NoError(t, other.Save(db, NewSimpleObj([]byte("x"), NewCounter(100))))
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		start, end []byte
+		reverse    bool
+		stopAt     string
+		fail       bool
+		want       string
+		wantCount  int64
+	}{
+		"all":             {want: "abcd", wantCount: 10},
+		"all reverse":     {reverse: true, want: "dcba", wantCount: 10},
+		"range":           {start: []byte("b"), end: []byte("d"), want: "bc", wantCount: 5},
+		"range reverse":   {start: []byte("b"), end: []byte("d"), reverse: true, want: "cb", wantCount: 5},
+		"open end":        {start: []byte("c"), want: "cd", wantCount: 7},
+		"stop early":      {stopAt: "b", want: "ab", wantCount: 3},
+		"stop reverse":    {reverse: true, stopAt: "c", want: "dc", wantCount: 7},
+		"callback failed": {stopAt: "a", fail: true, want: "a", wantCount: 1},
+		"empty":           {start: []byte("e"), want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				keys  string
+				count int64
+			)
+			fn := func(obj Object) error {
+				keys += string(obj.Key())
+				count += obj.Value().(*Counter).Count
+				if string(obj.Key()) == tc.stopAt {
+					if tc.fail {
+						return errBoom
+					}
+					return StopIteration
+				}
+				return nil
+			}
+
+			var err error
+			if tc.reverse {
+				err = bucket.ReverseIterate(db, tc.start, tc.end, fn)
+			} else {
+				err = bucket.Iterate(db, tc.start, tc.end, fn)
+			}
+			if tc.fail {
+				assert.Equal(t, errBoom, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, keys)
+			assert.Equal(t, tc.wantCount, count)
+		})
+	}
+}
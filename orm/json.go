@@ -0,0 +1,213 @@
+package orm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/iov-one/weave"
+)
+
+// ToJSON renders the value as canonical JSON: object keys are sorted
+// and there is no whitespace. Field names are taken from the json
+// struct tags of the generated protobuf types. Binary fields are
+// rendered in their string forms, conditions as "ext/type/HEX" and
+// anything else (eg. addresses) as upper case hex.
+func ToJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(jsonValue(reflect.ValueOf(v))); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+var bytesType = reflect.TypeOf([]byte(nil))
+
+// jsonValue converts v into maps, slices and scalars that are
+// encoded by encoding/json the way ToJSON describes
+func jsonValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return jsonValue(v.Elem())
+	case reflect.Struct:
+		return jsonStruct(v)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return bytesString(v.Convert(bytesType).Interface().([]byte))
+		}
+		if v.IsNil() {
+			return nil
+		}
+		fallthrough
+	case reflect.Array:
+		res := make([]interface{}, v.Len())
+		for i := range res {
+			res[i] = jsonValue(v.Index(i))
+		}
+		return res
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		res := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			res[fmt.Sprint(k.Interface())] = jsonValue(v.MapIndex(k))
+		}
+		return res
+	default:
+		return v.Interface()
+	}
+}
+
+func jsonStruct(v reflect.Value) map[string]interface{} {
+	res := make(map[string]interface{})
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		// unexported and protobuf internal fields
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		name, omitEmpty := jsonFieldName(f)
+		if name == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		// oneof fields are wrapped in a struct with a single field
+		if oneof, ok := oneofValue(fv); ok {
+			for k, val := range jsonStruct(oneof) {
+				res[k] = val
+			}
+			continue
+		}
+		if omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		res[name] = jsonValue(fv)
+	}
+	return res
+}
+
+// oneofValue returns the wrapper struct of a protobuf oneof field
+func oneofValue(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return reflect.Value{}, false
+	}
+	ptr := v.Elem()
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	return ptr.Elem(), true
+}
+
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "" {
+		return f.Name, false
+	}
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			return name, true
+		}
+	}
+	return name, false
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// bytesString renders binary data, detecting conditions
+func bytesString(bz []byte) interface{} {
+	if bz == nil {
+		return nil
+	}
+	if c := weave.Condition(bz); c.Validate() == nil {
+		return c.String()
+	}
+	return fmt.Sprintf("%X", bz)
+}
+
+// withJSON runs the query with the JSON flag removed from the
+// modifier and, if the flag was set, renders the values of the
+// result as JSON (see ToJSON)
+func (b Bucket) withJSON(mod string, query func(mod string) ([]weave.Model, error)) ([]weave.Model, error) {
+	mod, asJSON := weave.ParseQueryMod(mod)
+	models, err := query(mod)
+	if err != nil || !asJSON {
+		return models, err
+	}
+	toJSON := b.valueJSON
+	if _, ok := weave.ParseCountMod(mod); ok {
+		toJSON = countJSON
+	}
+	res := make([]weave.Model, len(models))
+	for i, m := range models {
+		value, err := toJSON(m.Value)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = weave.Model{Key: m.Key, Value: value}
+	}
+	return res, nil
+}
+
+// valueJSON decodes a stored value with the proto of the bucket
+// and renders it as JSON. Raw values are rendered as hex strings
+// if the bucket has no proto, deleted versions as null.
+func (b Bucket) valueJSON(value []byte) ([]byte, error) {
+	switch {
+	case b.proto == nil:
+		return ToJSON(value)
+	case bytes.Equal(value, deletedVersion):
+		return []byte("null"), nil
+	}
+	obj, err := b.Parse(nil, value)
+	if err != nil {
+		return nil, err
+	}
+	return ToJSON(obj.Value())
+}
+
+// indexQuery serves queries of an index registered with a bucket,
+// so that the referenced objects can be rendered as JSON
+type indexQuery struct {
+	index  Index
+	bucket Bucket
+}
+
+var _ weave.QueryHandler = indexQuery{}
+
+func (q indexQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	return q.bucket.withJSON(mod, func(mod string) ([]weave.Model, error) {
+		return q.index.Query(db, mod, data)
+	})
+}
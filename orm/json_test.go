@@ -0,0 +1,102 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToJSON(t *testing.T) {
+	type inner struct {
+		Name string `json:"name,omitempty"`
+	}
+	type oneofWrapper struct {
+		Inner *inner `json:"inner,omitempty"`
+	}
+	type model struct {
+		Owner     weave.Address `json:"owner,omitempty"`
+		Arbiter   []byte        `json:"arbiter,omitempty"`
+		Count     int64         `json:"count,omitempty"`
+		Zero      int64         `json:"zero,omitempty"`
+		Always    int64         `json:"always"`
+		List      []*inner      `json:"list,omitempty"`
+		Sum       interface{}   `json:"sum"`
+		Text      string        `json:"text,omitempty"`
+		Skipped   string        `json:"-"`
+		XXX_extra []byte        `json:"-"`
+		hidden    string
+	}
+
+	cond := weave.NewCondition("sigs", "ed25519", []byte{0xAB, 0xCD})
+	m := model{
+		Owner:   cond.Address(),
+		Arbiter: cond,
+		Count:   -5,
+		List:    []*inner{{Name: "a"}, nil},
+		Sum:     &oneofWrapper{Inner: &inner{Name: "b"}},
+		Text:    "<&>",
+		Skipped: "x",
+		hidden:  "y",
+	}
+	got, err := ToJSON(&m)
+	require.NoError(t, err)
+	want := `{"always":0,"arbiter":"sigs/ed25519/ABCD","count":-5,"inner":{"name":"b"},"list":[{"name":"a"},null],` +
+		`"owner":"` + cond.Address().String() + `","text":"<&>"}`
+	assert.Equal(t, want, string(got))
+}
+
+func TestQueryJSON(t *testing.T) {
+	bucket := NewBucket("jsonb", NewSimpleObj(nil, new(Counter))).
+		WithIndex("count", countByte, false)
+	db := store.MemStore()
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("a"), NewCounter(7))))
+	require.NoError(t, bucket.Save(db, NewSimpleObj([]byte("b"), NewCounter(7))))
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	bucket.Register("", qr)
+
+	cases := map[string]struct {
+		path, mod string
+		data      []byte
+		want      []string
+	}{
+		"key":          {"/jsonb", "json", []byte("a"), []string{`{"count":7}`}},
+		"prefix":       {"/jsonb", "prefix,json", nil, []string{`{"count":7}`, `{"count":7}`}},
+		"index":        {"/jsonb/count", "json", bc(7), []string{`{"count":7}`, `{"count":7}`}},
+		"raw root key": {"/", "json", bucket.DBKey([]byte("a")), []string{`"0807"`}},
+		"no json":      {"/jsonb", "", []byte("b"), []string{"\x08\x07"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			models, err := qr.Handler(tc.path).Query(db, tc.mod, tc.data)
+			require.NoError(t, err)
+			var got []string
+			for _, m := range models {
+				got = append(got, string(m.Value))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseQueryMod(t *testing.T) {
+	cases := map[string]struct {
+		mod    string
+		asJSON bool
+	}{
+		"":            {"", false},
+		"prefix":      {"prefix", false},
+		"json":        {"", true},
+		"prefix,json": {"prefix", true},
+		"jsonx":       {"jsonx", false},
+	}
+	for in, want := range cases {
+		mod, asJSON := weave.ParseQueryMod(in)
+		assert.Equal(t, want.mod, mod, in)
+		assert.Equal(t, want.asJSON, asJSON, in)
+	}
+}
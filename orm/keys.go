@@ -0,0 +1,117 @@
+package orm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Order-preserving encodings of numbers, to be used by indexers.
+//
+// The byte representation of the encoded values compares
+// (bytes.Compare) the same way as the numbers, so they can be used
+// for range queries on an index (see Index.GetRange).
+
+// Uint64Key encodes an unsigned integer as 8 bytes, big-endian.
+func Uint64Key(v uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return bz
+}
+
+// ParseUint64Key decodes a value encoded with Uint64Key.
+func ParseUint64Key(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, InvalidIndexErr.New(fmt.Sprintf("invalid uint64 key length: %d", len(bz)))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// Int64Key encodes a signed integer as 8 bytes, big-endian, with the
+// sign bit flipped. This way negative numbers are ordered before
+// positive ones.
+//
+// Note that this differs from the Sequence encoding, which is only
+// order-preserving for non-negative values.
+func Int64Key(v int64) []byte {
+	return Uint64Key(uint64(v) ^ signBit)
+}
+
+// ParseInt64Key decodes a value encoded with Int64Key.
+func ParseInt64Key(bz []byte) (int64, error) {
+	v, err := ParseUint64Key(bz)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ signBit), nil
+}
+
+const signBit = 1 << 63
+
+// Compound keys are built from several segments. Every segment is
+// escaped and terminated, so that the encoding of any leading subset
+// of segments is a prefix of the full key and segments of variable
+// length cannot be confused with each other. The order of compound
+// keys is the same as comparing them segment by segment.
+//
+// Within a segment 0x00 is encoded as 0x00 0xFF and every segment
+// is terminated by 0x00 0x01.
+const (
+	segmentEscape     = 0x00
+	segmentEscaped    = 0xFF
+	segmentTerminator = 0x01
+)
+
+// CompoundKey encodes the given segments into a single key.
+// Use it to query a compound index (see Bucket.WithCompoundIndex)
+// by all or any leading subset of its fields, with the prefix
+// query modifier for the latter.
+func CompoundKey(segments ...[]byte) []byte {
+	var size int
+	for _, s := range segments {
+		size += len(s) + 2
+	}
+	res := make([]byte, 0, size)
+	for _, s := range segments {
+		for _, b := range s {
+			if b == segmentEscape {
+				res = append(res, segmentEscape, segmentEscaped)
+				continue
+			}
+			res = append(res, b)
+		}
+		res = append(res, segmentEscape, segmentTerminator)
+	}
+	return res
+}
+
+// ParseCompoundKey decodes a key encoded with CompoundKey
+// into its segments.
+func ParseCompoundKey(key []byte) ([][]byte, error) {
+	var (
+		res     [][]byte
+		segment = []byte{}
+	)
+	for i := 0; i < len(key); i++ {
+		if key[i] != segmentEscape {
+			segment = append(segment, key[i])
+			continue
+		}
+		i++
+		if i == len(key) {
+			return nil, InvalidIndexErr.New("truncated compound key")
+		}
+		switch key[i] {
+		case segmentEscaped:
+			segment = append(segment, segmentEscape)
+		case segmentTerminator:
+			res = append(res, segment)
+			segment = []byte{}
+		default:
+			return nil, InvalidIndexErr.New(fmt.Sprintf("invalid compound key escape: %X", key[i]))
+		}
+	}
+	if len(segment) != 0 {
+		return nil, InvalidIndexErr.New("unterminated compound key segment")
+	}
+	return res, nil
+}
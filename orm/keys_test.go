@@ -0,0 +1,191 @@
+package orm
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64KeyOrder(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 255, 256, 1000, math.MaxInt64}
+	keys := make([][]byte, len(values))
+	for i, v := range values {
+		keys[i] = Int64Key(v)
+		got, err := ParseInt64Key(keys[i])
+		require.NoError(t, err)
+		assert.Equal(t, v, got)
+	}
+	assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	}))
+
+	_, err := ParseInt64Key([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestUint64KeyOrder(t *testing.T) {
+	values := []uint64{0, 1, 255, 256, math.MaxUint32, math.MaxUint64}
+	keys := make([][]byte, len(values))
+	for i, v := range values {
+		keys[i] = Uint64Key(v)
+		got, err := ParseUint64Key(keys[i])
+		require.NoError(t, err)
+		assert.Equal(t, v, got)
+	}
+	assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	}))
+}
+
+// sortableCount indexes a Counter with an order-preserving encoding
+func sortableCount(obj Object) ([]byte, error) {
+	cntr, ok := obj.Value().(*Counter)
+	if !ok {
+		return nil, errors.New("Can only take index of Counter")
+	}
+	return Int64Key(cntr.Count), nil
+}
+
+func TestIndexGetRange(t *testing.T) {
+	bucket := NewBucket("ranges", NewSimpleObj(nil, new(Counter))).
+		WithIndex("count", sortableCount, false)
+
+	db := store.MemStore()
+	counts := map[string]int64{"a": 1, "b": 3, "c": 3, "d": 10, "e": 42}
+	for key, n := range counts {
+		require.NoError(t, bucket.Save(db, NewSimpleObj([]byte(key), NewCounter(n))))
+	}
+
+	keys := func(objs []Object) string {
+		var res []byte
+		for _, o := range objs {
+			res = append(res, o.Key()...)
+		}
+		return string(res)
+	}
+
+	cases := map[string]struct {
+		start, end []byte
+		limit      int
+		reverse    bool
+		want       string
+	}{
+		"everything":      {nil, nil, 0, false, "abcde"},
+		"everything desc": {nil, nil, 0, true, "edcba"},
+		"lower bound":     {Int64Key(2), nil, 0, false, "bcde"},
+		"upper bound":     {nil, Int64Key(10), 0, false, "abc"},
+		"both bounds":     {Int64Key(3), Int64Key(42), 0, false, "bcd"},
+		"both desc":       {Int64Key(3), Int64Key(42), 0, true, "dcb"},
+		"limit":           {nil, nil, 2, false, "ab"},
+		"limit desc":      {nil, nil, 3, true, "edc"},
+		"empty":           {Int64Key(11), Int64Key(42), 0, false, ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := bucket.GetIndexedRange(db, "count", tc.start, tc.end, tc.limit, tc.reverse)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, keys(objs))
+		})
+	}
+
+	_, err := bucket.GetIndexedRange(db, "unknown", nil, nil, 0, false)
+	assert.True(t, IsInvalidIndexErr(err))
+}
+
+func TestCompoundKey(t *testing.T) {
+	cases := [][][]byte{
+		{},
+		{[]byte("a")},
+		{{}, []byte("b")},
+		{[]byte("ab"), []byte("c")},
+		{[]byte("a"), []byte("bc")},
+		{{0, 1, 0}, {0xFF, 0}},
+	}
+	for _, segments := range cases {
+		got, err := ParseCompoundKey(CompoundKey(segments...))
+		require.NoError(t, err)
+		assert.Equal(t, len(segments), len(got))
+		for i := range segments {
+			assert.Equal(t, segments[i], got[i])
+		}
+	}
+
+	// segments of variable length cannot be confused
+	assert.NotEqual(t, CompoundKey([]byte("ab"), []byte("c")), CompoundKey([]byte("a"), []byte("bc")))
+
+	// any leading subset is a prefix, but a longer first segment is not
+	full := CompoundKey([]byte("a"), []byte{0}, []byte("c"))
+	assert.True(t, bytes.HasPrefix(full, CompoundKey([]byte("a"))))
+	assert.True(t, bytes.HasPrefix(full, CompoundKey([]byte("a"), []byte{0})))
+	assert.False(t, bytes.HasPrefix(CompoundKey([]byte("ab")), CompoundKey([]byte("a"))))
+
+	// order is the same as comparing segment by segment
+	ordered := [][]byte{
+		CompoundKey([]byte("a"), []byte("z")),
+		CompoundKey([]byte{'a', 0}, []byte("a")),
+		CompoundKey([]byte{'a', 0, 0}),
+		CompoundKey([]byte{'a', 1}),
+		CompoundKey([]byte("b")),
+	}
+	assert.True(t, sort.SliceIsSorted(ordered, func(i, j int) bool {
+		return bytes.Compare(ordered[i], ordered[j]) < 0
+	}))
+
+	for _, invalid := range [][]byte{{'a'}, {'a', 0}, {0, 2}} {
+		_, err := ParseCompoundKey(invalid)
+		assert.Error(t, err)
+	}
+}
+
+// countByKey indexes a counter by its count and primary key
+func countByKey(obj Object) ([][]byte, error) {
+	cntr, ok := obj.Value().(*Counter)
+	if !ok {
+		return nil, errors.New("Can only take index of Counter")
+	}
+	return [][]byte{Int64Key(cntr.Count), obj.Key()}, nil
+}
+
+func TestCompoundIndex(t *testing.T) {
+	bucket := NewBucket("compound", NewSimpleObj(nil, new(Counter))).
+		WithCompoundIndex("count_key", countByKey, true)
+
+	db := store.MemStore()
+	counts := map[string]int64{"a": 1, "b": 3, "c": 3, "d": 10}
+	for key, n := range counts {
+		require.NoError(t, bucket.Save(db, NewSimpleObj([]byte(key), NewCounter(n))))
+	}
+
+	qr := weave.NewQueryRouter()
+	bucket.Register("compound", qr)
+	h := qr.Handler("/compound/count_key")
+	require.NotNil(t, h)
+
+	cases := map[string]struct {
+		mod  string
+		data []byte
+		want []string
+	}{
+		"all fields":     {weave.KeyQueryMod, CompoundKey(Int64Key(3), []byte("c")), []string{"c"}},
+		"leading subset": {weave.PrefixQueryMod, CompoundKey(Int64Key(3)), []string{"b", "c"}},
+		"no match":       {weave.PrefixQueryMod, CompoundKey(Int64Key(4)), nil},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			models, err := h.Query(db, tc.mod, tc.data)
+			require.NoError(t, err)
+			var got []string
+			for _, m := range models {
+				got = append(got, string(m.Key[len("compound:"):]))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+var migrationPrefix = []byte("_migration:")
+
+// Migration is a one-time modification of the state, needed when a
+// new version of the code stores data differently, eg. when an index
+// or a counter is added to a bucket that already holds data.
+type Migration struct {
+	// Name identifies the migration, it is recorded in the state
+	// once the migration is applied and must never change
+	Name string
+	// Run modifies the state. It must be deterministic.
+	Run func(db weave.KVStore) error
+}
+
+// Migrations is a weave.Ticker that applies, in order, all migrations
+// that were not applied yet.
+//
+// All nodes of a chain switch to a new version of the code at the
+// same height, so every node applies the migrations of that version
+// at the beginning of the first block it processes. A new chain
+// applies them to the (empty) state of its first block.
+type Migrations []Migration
+
+var _ weave.Ticker = Migrations(nil)
+
+// Tick applies all pending migrations
+func (ms Migrations) Tick(ctx weave.Context, db weave.KVStore) (weave.TickResult, error) {
+	var res weave.TickResult
+	for _, m := range ms {
+		key := append(migrationPrefix[:len(migrationPrefix):len(migrationPrefix)], m.Name...)
+		if db.Has(key) {
+			continue
+		}
+		if err := m.Run(db); err != nil {
+			return res, errors.Wrap(err, "migration "+m.Name)
+		}
+		db.Set(key, []byte{1})
+		weave.GetLogger(ctx).Info("migration applied", "name", m.Name)
+	}
+	return res, nil
+}
+
+// IndexMigration returns a migration that builds all indexes of the
+// bucket from the stored objects, see RepairIndexes. Use it when
+// adding an index to a bucket that may already hold data, otherwise
+// the existing objects are missing from the index and cannot be
+// updated or deleted.
+func IndexMigration(name string, b Bucket) Migration {
+	return Migration{
+		Name: name,
+		Run: func(db weave.KVStore) error {
+			_, err := b.RepairIndexes(db)
+			return err
+		},
+	}
+}
+
+// CounterMigration returns a migration that initializes the counter
+// of the bucket, see RepairCounter. Use it when enabling the counter
+// of a bucket that may already hold data.
+func CounterMigration(name string, b Bucket) Migration {
+	return Migration{
+		Name: name,
+		Run: func(db weave.KVStore) error {
+			_, err := b.RepairCounter(db)
+			return err
+		},
+	}
+}
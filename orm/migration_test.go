@@ -0,0 +1,62 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations(t *testing.T) {
+	var runs []string
+	migration := func(name string, err error) Migration {
+		return Migration{
+			Name: name,
+			Run: func(db weave.KVStore) error {
+				runs = append(runs, name)
+				return err
+			},
+		}
+	}
+	ctx := context.Background()
+	db := store.MemStore()
+
+	_, err := Migrations{migration("one", nil), migration("two", nil)}.Tick(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, runs)
+
+	// applied migrations are skipped, new ones are applied
+	_, err = Migrations{migration("one", nil), migration("two", nil), migration("three", nil)}.Tick(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, runs)
+
+	// a failure is returned and the migration is retried
+	failing := Migrations{migration("four", errors.New("boom"))}
+	_, err = failing.Tick(ctx, db)
+	assert.Error(t, err)
+	_, err = failing.Tick(ctx, db)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"one", "two", "three", "four", "four"}, runs)
+}
+
+func TestIndexMigration(t *testing.T) {
+	db := store.MemStore()
+	old := NewBucket("migr", NewSimpleObj(nil, new(Counter)))
+	require.NoError(t, old.Save(db, NewSimpleObj([]byte("a"), NewCounter(5))))
+
+	// the index is added to a bucket holding data
+	bucket := old.WithIndex("count", count, true)
+	err := bucket.Delete(db, []byte("a"))
+	assert.Error(t, err)
+
+	_, err = Migrations{IndexMigration("migr_count", bucket)}.Tick(context.Background(), db)
+	require.NoError(t, err)
+	objs, err := bucket.GetIndexed(db, "count", encodeSequence(5))
+	require.NoError(t, err)
+	assert.Len(t, objs, 1)
+	require.NoError(t, bucket.Delete(db, []byte("a")))
+}
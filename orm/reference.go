@@ -0,0 +1,175 @@
+package orm
+
+import (
+	"fmt"
+
+	"github.com/iov-one/weave"
+)
+
+// OnDelete defines what happens to the objects referencing
+// an object that is deleted
+type OnDelete int
+
+const (
+	// RejectDelete fails the deletion while the object is referenced
+	RejectDelete OnDelete = iota
+	// CascadeDelete deletes all objects referencing the object
+	CascadeDelete
+	// NullifyReference removes the reference from all objects
+	// referencing the object, see Reference.Clear
+	NullifyReference
+)
+
+// Reference declares that the objects of a bucket point to objects
+// stored in another bucket, by their primary keys.
+//
+// Saving an object fails if any of the referenced objects does not
+// exist. Deleting a referenced object applies the OnDelete policy.
+type Reference struct {
+	// Name of the reference. The referenced keys are stored in
+	// a secondary index of the bucket called "ref_<name>".
+	Name string
+	// Target is the name of the referenced bucket
+	Target string
+	// Keys returns the primary keys of the objects in the target
+	// bucket referenced by the object. Empty keys are ignored.
+	Keys MultiKeyIndexer
+	// OnDelete is the policy applied when a referenced object
+	// is deleted
+	OnDelete OnDelete
+	// Clear removes the reference to the given key from the object.
+	// It is required by NullifyReference.
+	Clear func(obj Object, key []byte) error
+}
+
+// Referrer is a bucket declaring references, or a type-safe wrapper
+// embedding one. Its Save and Delete are used to apply the OnDelete
+// policies, so any check or history of the wrapper is preserved.
+type Referrer interface {
+	Name() string
+	Get(db weave.ReadOnlyKVStore, key []byte) (Object, error)
+	Save(db weave.KVStore, model Object) error
+	Delete(db weave.KVStore, key []byte) error
+	// implemented by Bucket
+	referencesTo(target string) []Reference
+	referring(db weave.ReadOnlyKVStore, ref Reference, key []byte) ([][]byte, error)
+}
+
+var _ Referrer = Bucket{}
+
+// WithReference returns a copy of this bucket enforcing the reference,
+// panics if it is not valid.
+//
+// Saving an object checks the referenced objects exist. The OnDelete
+// policy is only applied by a target bucket knowing this bucket,
+// see WithReferrer.
+//
+// Designed to be chained.
+func (b Bucket) WithReference(ref Reference) Bucket {
+	switch {
+	case ref.Name == "" || ref.Target == "" || ref.Keys == nil:
+		panic(fmt.Sprintf("Invalid reference %q on %s", ref.Name, b.name))
+	case ref.OnDelete == NullifyReference && ref.Clear == nil:
+		panic(fmt.Sprintf("Reference %s on %s cannot be nullified", ref.Name, b.name))
+	}
+	refs := make([]Reference, len(b.refs), len(b.refs)+1)
+	copy(refs, b.refs)
+	b.refs = append(refs, ref)
+	return b.WithMultiKeyIndex(refIndexName(ref), ref.Keys, false)
+}
+
+// WithReferrer returns a copy of this bucket that applies the OnDelete
+// policies of all references of src to this bucket when deleting an
+// object. Referrers are released in order of registration.
+// Panics if src has no reference to this bucket.
+//
+// Designed to be chained.
+func (b Bucket) WithReferrer(src Referrer) Bucket {
+	if len(src.referencesTo(b.name)) == 0 {
+		panic(fmt.Sprintf("%s has no reference to %s", src.Name(), b.name))
+	}
+	referrers := make([]Referrer, len(b.referrers), len(b.referrers)+1)
+	copy(referrers, b.referrers)
+	b.referrers = append(referrers, src)
+	return b
+}
+
+func refIndexName(ref Reference) string {
+	return "ref_" + ref.Name
+}
+
+// referencesTo returns all references to the target bucket
+func (b Bucket) referencesTo(target string) []Reference {
+	var res []Reference
+	for _, ref := range b.refs {
+		if ref.Target == target {
+			res = append(res, ref)
+		}
+	}
+	return res
+}
+
+// referring returns the primary keys of all objects referencing
+// the key with the reference
+func (b Bucket) referring(db weave.ReadOnlyKVStore, ref Reference, key []byte) ([][]byte, error) {
+	return b.indexes.Get(refIndexName(ref)).GetAt(db, key)
+}
+
+// checkReferences ensures all objects referenced by the model exist
+func (b Bucket) checkReferences(db weave.ReadOnlyKVStore, model Object) error {
+	for _, ref := range b.refs {
+		keys, err := ref.Keys(model)
+		if err != nil {
+			return err
+		}
+		target := append([]byte(ref.Target), ':')
+		for _, key := range keys {
+			if len(key) == 0 {
+				continue
+			}
+			if !db.Has(append(target[:len(target):len(target)], key...)) {
+				return ErrDanglingReference(fmt.Sprintf("%s %X", ref.Target, key))
+			}
+		}
+	}
+	return nil
+}
+
+// releaseReferrers applies the OnDelete policies of all references
+// to the object with the given key, before it is deleted
+func (b Bucket) releaseReferrers(db weave.KVStore, key []byte) error {
+	for _, src := range b.referrers {
+		for _, ref := range src.referencesTo(b.name) {
+			pks, err := src.referring(db, ref, key)
+			if err != nil {
+				return err
+			}
+			for _, pk := range pks {
+				if err := release(db, src, ref, pk, key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// release applies the policy of the reference to the object
+// of src with the primary key pk, pointing to the target key
+func release(db weave.KVStore, src Referrer, ref Reference, pk, key []byte) error {
+	switch ref.OnDelete {
+	case CascadeDelete:
+		return src.Delete(db, pk)
+	case NullifyReference:
+		obj, err := src.Get(db, pk)
+		if err != nil {
+			return err
+		}
+		if err := ref.Clear(obj, key); err != nil {
+			return err
+		}
+		return src.Save(db, obj)
+	default:
+		return ErrReferenced(fmt.Sprintf("%s %X by %s %X", ref.Target, key, src.Name(), pk))
+	}
+}
@@ -0,0 +1,130 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// multiRefKeys references all keys of a MultiRef
+func multiRefKeys(obj Object) ([][]byte, error) {
+	return obj.Value().(*MultiRef).Refs, nil
+}
+
+func clearMultiRef(obj Object, key []byte) error {
+	return obj.Value().(*MultiRef).Remove(key)
+}
+
+func TestReferences(t *testing.T) {
+	cases := map[string]struct {
+		policy OnDelete
+		// check is called after deleting the referenced object
+		check func(t *testing.T, err error, src Bucket, db store.CacheableKVStore)
+	}{
+		"reject": {
+			policy: RejectDelete,
+			check: func(t *testing.T, err error, src Bucket, db store.CacheableKVStore) {
+				assert.True(t, IsReferencedErr(err))
+			},
+		},
+		"cascade": {
+			policy: CascadeDelete,
+			check: func(t *testing.T, err error, src Bucket, db store.CacheableKVStore) {
+				require.NoError(t, err)
+				obj, err := src.Get(db, []byte("src"))
+				require.NoError(t, err)
+				assert.Nil(t, obj)
+				// the reference index is cleaned up
+				objs, err := src.GetIndexed(db, "ref_owner", []byte("b"))
+				require.NoError(t, err)
+				assert.Empty(t, objs)
+			},
+		},
+		"nullify": {
+			policy: NullifyReference,
+			check: func(t *testing.T, err error, src Bucket, db store.CacheableKVStore) {
+				require.NoError(t, err)
+				obj, err := src.Get(db, []byte("src"))
+				require.NoError(t, err)
+				assert.Equal(t, [][]byte{[]byte("a")}, obj.Value().(*MultiRef).Refs)
+				objs, err := src.GetIndexed(db, "ref_owner", []byte("a"))
+				require.NoError(t, err)
+				assert.Len(t, objs, 1)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			target := NewBucket("reft", NewSimpleObj(nil, new(Counter)))
+			src := NewBucket("refs", NewSimpleObj(nil, new(MultiRef))).
+				WithReference(Reference{
+					Name:     "owner",
+					Target:   target.Name(),
+					Keys:     multiRefKeys,
+					OnDelete: tc.policy,
+					Clear:    clearMultiRef,
+				})
+			target = target.WithReferrer(src)
+
+			db := store.MemStore()
+			require.NoError(t, target.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+			require.NoError(t, target.Save(db, NewSimpleObj([]byte("b"), NewCounter(2))))
+
+			// dangling references are rejected
+			ref, _ := NewMultiRef([]byte("a"), []byte("missing"))
+			err := src.Save(db, NewSimpleObj([]byte("src"), ref))
+			assert.True(t, IsDanglingReferenceErr(err))
+
+			ref, _ = NewMultiRef([]byte("a"), []byte("b"))
+			require.NoError(t, src.Save(db, NewSimpleObj([]byte("src"), ref)))
+
+			err = target.Delete(db, []byte("b"))
+			tc.check(t, err, src, db)
+		})
+	}
+}
+
+func TestReferrerWrapper(t *testing.T) {
+	target := NewBucket("reft", NewSimpleObj(nil, new(Counter)))
+	src := NewBucket("refs", NewSimpleObj(nil, new(MultiRef))).
+		WithReference(Reference{
+			Name:     "owner",
+			Target:   target.Name(),
+			Keys:     multiRefKeys,
+			OnDelete: CascadeDelete,
+		}).
+		WithHistory()
+	target = target.WithReferrer(src)
+
+	db := store.WithHeight(store.MemStore(), 10)
+	require.NoError(t, target.Save(db, NewSimpleObj([]byte("a"), NewCounter(1))))
+	ref, _ := NewMultiRef([]byte("a"))
+	require.NoError(t, src.Save(db, NewSimpleObj([]byte("src"), ref)))
+
+	// the deletion goes through the versioned bucket
+	require.NoError(t, target.Delete(store.WithHeight(db, 12), []byte("a")))
+	versions, err := src.History(db, []byte("src"))
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int64(12), versions[1].Height)
+	assert.Nil(t, versions[1].Object)
+}
+
+func TestReferenceValidation(t *testing.T) {
+	b := NewBucket("refinvalid", NewSimpleObj(nil, new(MultiRef)))
+	assert.Panics(t, func() { b.WithReference(Reference{Name: "x"}) })
+	assert.Panics(t, func() {
+		b.WithReference(Reference{
+			Name:     "x",
+			Target:   "refother",
+			Keys:     multiRefKeys,
+			OnDelete: NullifyReference,
+		})
+	})
+	// the referrer must reference the bucket
+	other := NewBucket("refother", NewSimpleObj(nil, new(Counter)))
+	assert.Panics(t, func() { other.WithReferrer(b) })
+}
@@ -2,6 +2,7 @@ package weave
 
 import (
 	"fmt"
+	"strings"
 )
 
 const (
@@ -12,8 +13,42 @@ const (
 	// RangeQueryMod means to expect complex range query
 	// TODO: implement
 	RangeQueryMod = "range"
+	// JSONQueryMod returns the values as canonical JSON instead of
+	// protobuf. It can be used alone (for a key query) or appended
+	// to any other modifier, eg. "prefix,json"
+	JSONQueryMod = "json"
+	// CountQueryMod returns the number of matching objects instead
+	// of the objects. Like the JSON flag, it can be used alone or
+	// appended to another modifier, eg. "prefix,count"
+	CountQueryMod = "count"
 )
 
+// ParseQueryMod removes the JSON flag from the query modifier.
+// It returns the remaining modifier and whether the flag was set.
+func ParseQueryMod(mod string) (string, bool) {
+	switch {
+	case mod == JSONQueryMod:
+		return KeyQueryMod, true
+	case strings.HasSuffix(mod, ","+JSONQueryMod):
+		return strings.TrimSuffix(mod, ","+JSONQueryMod), true
+	}
+	return mod, false
+}
+
+// ParseCountMod removes the count flag from the query modifier.
+// It returns the remaining modifier and whether the flag was set.
+// The JSON flag must be removed first (see ParseQueryMod), as it
+// always comes last, eg. "prefix,count,json"
+func ParseCountMod(mod string) (string, bool) {
+	switch {
+	case mod == CountQueryMod:
+		return KeyQueryMod, true
+	case strings.HasSuffix(mod, ","+CountQueryMod):
+		return strings.TrimSuffix(mod, ","+CountQueryMod), true
+	}
+	return mod, false
+}
+
 // Model groups together key and value to return
 type Model struct {
 	Key   []byte
@@ -75,3 +110,12 @@ func (r QueryRouter) Register(path string, h QueryHandler) {
 func (r QueryRouter) Handler(path string) QueryHandler {
 	return r.routes[path]
 }
+
+// Routes returns a copy of all registered handlers by path
+func (r QueryRouter) Routes() map[string]QueryHandler {
+	res := make(map[string]QueryHandler, len(r.routes))
+	for path, h := range r.routes {
+		res[path] = h
+	}
+	return res
+}
@@ -28,6 +28,11 @@ func (b BTreeCacheable) CacheWrap() KVCacheWrap {
 	return NewBTreeCacheWrap(b.KVStore, b.NewBatch(), nil)
 }
 
+// Height returns the height known by the wrapped store, if any
+func (b BTreeCacheable) Height() (int64, bool) {
+	return GetHeight(b.KVStore)
+}
+
 // MemStore returns a simple implementation useful for tests.
 // There is no persistence here....
 func MemStore() CacheableKVStore {
@@ -91,6 +96,11 @@ func (b BTreeCacheWrap) CacheWrap() KVCacheWrap {
 	return NewBTreeCacheWrap(b, b.NewBatch(), b.free)
 }
 
+// Height returns the height known by the cached store, if any
+func (b BTreeCacheWrap) Height() (int64, bool) {
+	return GetHeight(b.back)
+}
+
 // NewBatch returns a non-atomic batch that eventually may write to
 // our cachewrap
 func (b BTreeCacheWrap) NewBatch() Batch {
@@ -0,0 +1,213 @@
+/*
+Package changelog streams the state changes of every committed block
+to an append-only file, so indexers can follow exactly which keys
+changed without scanning the whole tree.
+
+Each line of the file is a JSON encoded Block. Use Writer.Append as
+an app.CommitHook to produce the file and Reader to consume it.
+
+The first line of a file marks the height of the state when the
+changelog was enabled, all following lines are consecutive blocks.
+A writer can only be opened on a file ending at the committed height,
+so a block that was committed but not written cannot be skipped.
+*/
+package changelog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iov-one/weave/store"
+)
+
+// Block contains all changes made to the state in one block
+type Block struct {
+	Height  int64    `json:"height"`
+	Changes []Change `json:"changes"`
+	// Start marks the first line of a file. It holds no changes,
+	// only the height of the state when the changelog was enabled.
+	Start bool `json:"start,omitempty"`
+}
+
+// Change describes the modification of a single key.
+// Bucket is decoded from the key prefix (see store.KeyPrefix)
+type Change struct {
+	Bucket string `json:"bucket"`
+	Key    []byte `json:"key"`
+	Prev   []byte `json:"prev,omitempty"`
+	Value  []byte `json:"value,omitempty"`
+}
+
+// NewBlock converts a changeset to its serializable form
+func NewBlock(height int64, changes store.Changeset) Block {
+	res := Block{
+		Height:  height,
+		Changes: make([]Change, len(changes)),
+	}
+	for i, c := range changes {
+		res.Changes[i] = Change{
+			Bucket: c.Bucket(),
+			Key:    c.Key,
+			Prev:   c.Prev,
+			Value:  c.Value,
+		}
+	}
+	return res
+}
+
+// Writer appends blocks to a changelog file
+type Writer struct {
+	file *os.File
+	// height is the last height written to the file
+	height int64
+}
+
+// NewWriter opens the file at path for appending, given the height
+// of the committed state. A new file starts at that height.
+//
+// It fails if the file ends at another height: the blocks in between
+// are missing and would be silently skipped.
+func NewWriter(path string, height int64) (*Writer, error) {
+	last, err := LastHeight(path)
+	switch {
+	case os.IsNotExist(err):
+		last = -1
+	case err != nil:
+		return nil, err
+	case last != height:
+		return nil, fmt.Errorf("changelog ends at height %d, the state is at height %d", last, height)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open changelog: %s", err)
+	}
+	w := &Writer{file: f, height: height}
+	if last == -1 {
+		if err := w.write(Block{Height: height, Start: true}); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Append writes the changes of one block to the file and flushes
+// it to disk. It can be used as an app.CommitHook.
+// The height must follow the last written one.
+func (w *Writer) Append(height int64, changes store.Changeset) error {
+	if height != w.height+1 {
+		return fmt.Errorf("changelog is at height %d, cannot append %d", w.height, height)
+	}
+	if err := w.write(NewBlock(height, changes)); err != nil {
+		return err
+	}
+	w.height = height
+	return nil
+}
+
+func (w *Writer) write(b Block) error {
+	raw, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	if _, err := w.file.Write(append(raw, '\n')); err != nil {
+		return fmt.Errorf("cannot write changelog: %s", err)
+	}
+	return w.file.Sync()
+}
+
+// Close releases the file
+func (w *Writer) Close() error {
+	return w.file.Close()
+}
+
+// maxLineSize is the longest line (single block) that can be read
+const maxLineSize = 64 * 1024 * 1024
+
+// Reader reads blocks from a changelog, in the order
+// they were written
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+// NewReader reads a changelog from r
+func NewReader(r io.Reader) *Reader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &Reader{scanner: s}
+}
+
+// Next returns the next block from the changelog.
+// It returns io.EOF when there are no more blocks.
+func (r *Reader) Next() (*Block, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	var b Block
+	if err := json.Unmarshal(r.scanner.Bytes(), &b); err != nil {
+		return nil, fmt.Errorf("cannot decode changelog block: %s", err)
+	}
+	return &b, nil
+}
+
+// LastHeight returns the height of the last line of the changelog
+// file at path. The error satisfies os.IsNotExist if there is no file.
+func LastHeight(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := NewReader(f)
+	var last *Block
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		last = b
+	}
+	if last == nil {
+		return 0, fmt.Errorf("changelog %s is empty", path)
+	}
+	return last.Height, nil
+}
+
+// ReadFile calls fn for every block in the changelog file at path,
+// starting at the given height (0 means from the beginning).
+// The start line is skipped. It stops on the first error returned by fn.
+func ReadFile(path string, fromHeight int64, fn func(*Block) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := NewReader(f)
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if b.Start || b.Height < fromHeight {
+			continue
+		}
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package changelog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/iov-one/weave/store"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "changelog")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "changelog.jsonl")
+
+	blocks := map[int64]store.Changeset{
+		1: {
+			{Key: []byte("cash:alice"), Value: []byte("10")},
+		},
+		2: {
+			{Key: []byte("cash:alice"), Prev: []byte("10"), Value: []byte("5")},
+			{Key: []byte("escrow:1"), Prev: []byte("data")},
+		},
+		3: nil,
+	}
+
+	w, err := NewWriter(path, 0)
+	if err != nil {
+		t.Fatalf("cannot create writer: %s", err)
+	}
+	for h := int64(1); h <= 3; h++ {
+		if err := w.Append(h, blocks[h]); err != nil {
+			t.Fatalf("cannot append block %d: %s", h, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("cannot close: %s", err)
+	}
+
+	var got []*Block
+	err = ReadFile(path, 2, func(b *Block) error {
+		got = append(got, b)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cannot read: %s", err)
+	}
+
+	want := []*Block{
+		{
+			Height: 2,
+			Changes: []Change{
+				{Bucket: "cash", Key: []byte("cash:alice"), Prev: []byte("10"), Value: []byte("5")},
+				{Bucket: "escrow", Key: []byte("escrow:1"), Prev: []byte("data")},
+			},
+		},
+		{Height: 3, Changes: []Change{}},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("want %+v, got %+v", want, got)
+	}
+}
+
+func TestWriterHeight(t *testing.T) {
+	dir, err := ioutil.TempDir("", "changelog")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "changelog.jsonl")
+
+	// enabled on a chain at height 5
+	w, err := NewWriter(path, 5)
+	if err != nil {
+		t.Fatalf("cannot create writer: %s", err)
+	}
+	if err := w.Append(7, nil); err == nil {
+		t.Fatal("appended a block after a missing one")
+	}
+	if err := w.Append(6, nil); err != nil {
+		t.Fatalf("cannot append block: %s", err)
+	}
+	w.Close()
+
+	if h, err := LastHeight(path); err != nil || h != 6 {
+		t.Fatalf("want height 6, got %d (%v)", h, err)
+	}
+
+	// block 7 was committed but not written
+	if _, err := NewWriter(path, 7); err == nil {
+		t.Fatal("opened a changelog behind the state")
+	}
+	w, err = NewWriter(path, 6)
+	if err != nil {
+		t.Fatalf("cannot reopen writer: %s", err)
+	}
+	if err := w.Append(7, nil); err != nil {
+		t.Fatalf("cannot append block: %s", err)
+	}
+	w.Close()
+
+	var heights []int64
+	err = ReadFile(path, 0, func(b *Block) error {
+		heights = append(heights, b.Height)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cannot read: %s", err)
+	}
+	if !reflect.DeepEqual([]int64{6, 7}, heights) {
+		t.Fatalf("want heights 6 and 7, got %v", heights)
+	}
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Change describes the modification of a single key
+type Change struct {
+	// Key is the full store key that was modified
+	Key []byte
+	// Prev is the value before the first modification,
+	// nil if the key did not exist
+	Prev []byte
+	// Value is the value after the last modification,
+	// nil if the key was deleted
+	Value []byte
+}
+
+// IsCreate returns true iff the key did not exist before
+func (c Change) IsCreate() bool {
+	return c.Prev == nil && c.Value != nil
+}
+
+// IsDelete returns true iff the key no longer exists
+func (c Change) IsDelete() bool {
+	return c.Value == nil
+}
+
+// Bucket returns the name of the bucket the key belongs to.
+// See KeyPrefix for details.
+func (c Change) Bucket() string {
+	return KeyPrefix(c.Key)
+}
+
+// Reverse returns the operation that restores the previous value
+func (c Change) Reverse() Op {
+	if c.Prev == nil {
+		return DelOp(c.Key)
+	}
+	return SetOp(c.Key, c.Prev)
+}
+
+// String returns a compact, human readable form of the change
+func (c Change) String() string {
+	return fmt.Sprintf("%X: %X -> %X", c.Key, c.Prev, c.Value)
+}
+
+// Changeset is a list of changes to a store, ordered by key
+type Changeset []Change
+
+func (cs Changeset) sort() {
+	sort.Slice(cs, func(i, j int) bool {
+		return bytes.Compare(cs[i].Key, cs[j].Key) < 0
+	})
+}
+
+// Reverse returns all operations needed to restore the
+// state from before the changes were made
+func (cs Changeset) Reverse() []Op {
+	ops := make([]Op, len(cs))
+	for i, c := range cs {
+		ops[i] = c.Reverse()
+	}
+	return ops
+}
+
+// Rollback applies all reverse operations to the store
+func (cs Changeset) Rollback(db SetDeleter) {
+	for _, op := range cs.Reverse() {
+		op.Apply(db)
+	}
+}
+
+// Buckets returns a sorted list of all bucket names
+// touched by those changes
+func (cs Changeset) Buckets() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, c := range cs {
+		name := c.Bucket()
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// String returns all changes in a human readable form
+func (cs Changeset) String() string {
+	lines := make([]string, len(cs))
+	for i, c := range cs {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "; ")
+}
+
+// KeyPrefix returns the part of the key before the first ':',
+// which by convention is the name of the bucket (eg. "cash"),
+// index (eg. "_i.escrow_sender") or other subspace (eg. "gconf")
+// the key belongs to.
+//
+// If there is no separator, an empty string is returned.
+func KeyPrefix(key []byte) string {
+	i := bytes.IndexByte(key, ':')
+	if i < 0 {
+		return ""
+	}
+	return string(key[:i])
+}
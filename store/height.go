@@ -0,0 +1,42 @@
+package store
+
+// HeightStore is implemented by stores that know the height of the
+// block whose changes they hold, see WithHeight
+type HeightStore interface {
+	Height() (int64, bool)
+}
+
+// WithHeight returns a store that reports the given block height,
+// as do all cache wraps created from it. It lets code that versions
+// its data (eg. orm.VersionedBucket) know the current height without
+// passing it around with every call.
+func WithHeight(db CacheableKVStore, height int64) CacheableKVStore {
+	return heightStore{CacheableKVStore: db, height: height}
+}
+
+// GetHeight returns the block height known by this store, if any
+func GetHeight(db ReadOnlyKVStore) (int64, bool) {
+	h, ok := db.(HeightStore)
+	if !ok {
+		return 0, false
+	}
+	return h.Height()
+}
+
+type heightStore struct {
+	CacheableKVStore
+	height int64
+}
+
+var _ CacheableKVStore = heightStore{}
+var _ HeightStore = heightStore{}
+
+// Height returns the height given to WithHeight
+func (h heightStore) Height() (int64, bool) {
+	return h.height, true
+}
+
+// CacheWrap returns a cache that keeps reporting the height
+func (h heightStore) CacheWrap() KVCacheWrap {
+	return NewBTreeCacheWrap(h, h.NewBatch(), nil)
+}
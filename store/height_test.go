@@ -0,0 +1,33 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeightStore(t *testing.T) {
+	db := MemStore()
+	_, ok := GetHeight(db)
+	assert.False(t, ok)
+
+	hs := WithHeight(db, 17)
+	stores := map[string]ReadOnlyKVStore{
+		"height":    hs,
+		"cache":     hs.CacheWrap(),
+		"nested":    hs.CacheWrap().CacheWrap(),
+		"read only": ReadOnly(hs),
+		"recording": NewRecordingStore(hs),
+	}
+	for name, s := range stores {
+		height, ok := GetHeight(s)
+		assert.True(t, ok, name)
+		assert.Equal(t, int64(17), height, name)
+	}
+
+	// writes to the cache go to the wrapped store
+	cache := hs.CacheWrap()
+	cache.Set([]byte("foo"), []byte("bar"))
+	cache.Write()
+	assert.Equal(t, []byte("bar"), db.Get([]byte("foo")))
+}
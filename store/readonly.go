@@ -0,0 +1,62 @@
+package store
+
+import (
+	stderrors "errors"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// ErrReadOnly is the cause of the panic raised when a write is
+// attempted on a store returned by ReadOnly
+var ErrReadOnly = stderrors.New("write to read-only store")
+
+// IsReadOnlyErr returns true iff the error (or recovered panic
+// value) was caused by a write to a read-only store
+func IsReadOnlyErr(err error) bool {
+	return errors.IsSameError(ErrReadOnly, err)
+}
+
+// ReadOnly wraps a store so that all reads pass through, while
+// any attempt to write to it panics with ErrReadOnly.
+//
+// It is used to guarantee that query handlers cannot modify
+// the state they are given, and to verify handlers that are
+// expected to never write (eg. a strict check).
+//
+// CacheWrap is supported, so code creating savepoints still works.
+// Writes to the cache are fine, but calling Write on it panics.
+func ReadOnly(kv ReadOnlyKVStore) CacheableKVStore {
+	return readOnlyStore{kv}
+}
+
+type readOnlyStore struct {
+	ReadOnlyKVStore
+}
+
+var _ CacheableKVStore = readOnlyStore{}
+
+// Set always panics
+func (r readOnlyStore) Set(key, value []byte) {
+	panic(errors.Wrap(ErrReadOnly, "set"))
+}
+
+// Delete always panics
+func (r readOnlyStore) Delete(key []byte) {
+	panic(errors.Wrap(ErrReadOnly, "delete"))
+}
+
+// NewBatch always panics, as the batch could never be written
+func (r readOnlyStore) NewBatch() Batch {
+	panic(errors.Wrap(ErrReadOnly, "new batch"))
+}
+
+// CacheWrap returns a cache that can be written to and discarded,
+// but panics on Write
+func (r readOnlyStore) CacheWrap() KVCacheWrap {
+	return NewBTreeCacheWrap(r, NewNonAtomicBatch(r), nil)
+}
+
+// Height returns the height known by the wrapped store, if any
+func (r readOnlyStore) Height() (int64, bool) {
+	return GetHeight(r.ReadOnlyKVStore)
+}
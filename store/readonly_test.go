@@ -0,0 +1,59 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyStore(t *testing.T) {
+	base := MemStore()
+	k, v := []byte("foo"), []byte("bar")
+	base.Set(k, v)
+
+	ro := ReadOnly(base)
+
+	// all reads pass through
+	assert.Equal(t, v, ro.Get(k))
+	assert.True(t, ro.Has(k))
+	assert.Nil(t, ro.Get([]byte("missing")))
+	itr := ro.Iterator(nil, nil)
+	assert.True(t, itr.Valid())
+	assert.Equal(t, k, itr.Key())
+	itr.Close()
+
+	// all writes panic
+	assertReadOnlyPanic(t, func() { ro.Set(k, []byte("other")) })
+	assertReadOnlyPanic(t, func() { ro.Delete(k) })
+	assertReadOnlyPanic(t, func() { ro.NewBatch() })
+
+	// cache wraps can be used as scratch space and discarded
+	cache := ro.CacheWrap()
+	cache.Set([]byte("new"), []byte("value"))
+	assert.Equal(t, []byte("value"), cache.Get([]byte("new")))
+	cache.Discard()
+
+	// but never written back
+	cache = ro.CacheWrap()
+	cache.Delete(k)
+	assertReadOnlyPanic(t, cache.Write)
+
+	// nothing leaked to the underlying store
+	assert.Equal(t, v, base.Get(k))
+	assert.Nil(t, base.Get([]byte("new")))
+}
+
+func assertReadOnlyPanic(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected a panic")
+		}
+		err, ok := r.(error)
+		if !ok || !IsReadOnlyErr(err) {
+			t.Fatalf("unexpected panic: %v", r)
+		}
+	}()
+	fn()
+}
@@ -4,6 +4,7 @@ package store
 // NewRecordingStore
 type Recorder interface {
 	KVPairs() map[string][]byte
+	Changeset() Changeset
 }
 
 // NewRecordingStore initializes a recording store wrapping this
@@ -13,7 +14,7 @@ type Recorder interface {
 // wrapper so downstream components (like Savepoint) can use reflection
 // to CacheWrap.
 func NewRecordingStore(db KVStore) KVStore {
-	changes := make(map[string][]byte)
+	changes := newChanges()
 	if cached, ok := db.(CacheableKVStore); ok {
 		return &cacheableRecordingStore{
 			CacheableKVStore: cached,
@@ -31,30 +32,38 @@ func NewRecordingStore(db KVStore) KVStore {
 // recordingStore wraps a normal KVStore and records any change operations
 type recordingStore struct {
 	KVStore
-	// changes is a map from key to (recordSet|recordDelete)
-	changes map[string][]byte
+	changes *changes
 }
 
 var _ KVStore = (*recordingStore)(nil)
+var _ Recorder = (*recordingStore)(nil)
 
 // KVPairs returns the content of changes as KVPairs
 // Key is the merkle store key that changes.
-// Value is "s" or "d" for set or delete.
+// Value is the value writen (for set), or nil (for delete)
 func (r *recordingStore) KVPairs() map[string][]byte {
-	return r.changes
+	return r.changes.values
+}
+
+// Changeset returns all changes along with the previous values
+func (r *recordingStore) Changeset() Changeset {
+	return r.changes.changeset()
+}
+
+// Height returns the height known by the wrapped store, if any
+func (r *recordingStore) Height() (int64, bool) {
+	return GetHeight(r.KVStore)
 }
 
 // Set records the changes while performing
-//
-// TODO: record new value???
 func (r *recordingStore) Set(key, value []byte) {
-	r.changes[string(key)] = value
+	r.changes.record(r.KVStore, key, value)
 	r.KVStore.Set(key, value)
 }
 
 // Delete records the changes while performing
 func (r *recordingStore) Delete(key []byte) {
-	r.changes[string(key)] = nil
+	r.changes.record(r.KVStore, key, nil)
 	r.KVStore.Delete(key)
 }
 
@@ -62,6 +71,7 @@ func (r *recordingStore) Delete(key []byte) {
 func (r *recordingStore) NewBatch() Batch {
 	return &recorderBatch{
 		changes: r.changes,
+		db:      r.KVStore,
 		b:       r.KVStore.NewBatch(),
 	}
 }
@@ -72,30 +82,38 @@ func (r *recordingStore) NewBatch() Batch {
 // and records any change operations
 type cacheableRecordingStore struct {
 	CacheableKVStore
-	// changes is a map from key to (recordSet|recordDelete)
-	changes map[string][]byte
+	changes *changes
 }
 
 var _ CacheableKVStore = (*cacheableRecordingStore)(nil)
+var _ Recorder = (*cacheableRecordingStore)(nil)
 
 // KVPairs returns the content of changes as KVPairs
 // Key is the merkle store key that changes.
 // Value is the value writen (for set), or nil (for delete)
 func (r *cacheableRecordingStore) KVPairs() map[string][]byte {
-	return r.changes
+	return r.changes.values
+}
+
+// Changeset returns all changes along with the previous values
+func (r *cacheableRecordingStore) Changeset() Changeset {
+	return r.changes.changeset()
+}
+
+// Height returns the height known by the wrapped store, if any
+func (r *cacheableRecordingStore) Height() (int64, bool) {
+	return GetHeight(r.CacheableKVStore)
 }
 
 // Set records the changes while performing
-//
-// TODO: record new value???
 func (r *cacheableRecordingStore) Set(key, value []byte) {
-	r.changes[string(key)] = value
+	r.changes.record(r.CacheableKVStore, key, value)
 	r.CacheableKVStore.Set(key, value)
 }
 
 // Delete records the changes while performing
 func (r *cacheableRecordingStore) Delete(key []byte) {
-	r.changes[string(key)] = nil
+	r.changes.record(r.CacheableKVStore, key, nil)
 	r.CacheableKVStore.Delete(key)
 }
 
@@ -103,37 +121,90 @@ func (r *cacheableRecordingStore) Delete(key []byte) {
 func (r *cacheableRecordingStore) NewBatch() Batch {
 	return &recorderBatch{
 		changes: r.changes,
+		db:      r.CacheableKVStore,
 		b:       r.CacheableKVStore.NewBatch(),
 	}
 }
 
-// CacheWrap makes sure all cached writes also go through this
+// CacheWrap makes sure all cached writes also go through this.
+//
+// Changes are only recorded once the cache is written, so
+// discarded writes never show up in the changeset.
 func (r *cacheableRecordingStore) CacheWrap() KVCacheWrap {
 	// TODO: reuse FreeList between multiple cache wraps....
 	// We create/destroy a lot per tx when processing a block
-	return NewBTreeCacheWrap(r, r.NewBatch(), nil)
+	return NewBTreeCacheWrap(r, NewNonAtomicBatch(r), nil)
 }
 
 //----- batch recording, write to changes map from Recorder
 
+// recorderBatch records all operations when they are written,
+// so the previous values can still be read from db
 type recorderBatch struct {
-	changes map[string][]byte
+	changes *changes
+	db      ReadOnlyKVStore
 	b       Batch
+	ops     []Op
 }
 
 var _ Batch = (*recorderBatch)(nil)
 
+// Set queues the change to be recorded on Write
 func (r *recorderBatch) Set(key, value []byte) {
-	r.changes[string(key)] = value
+	r.ops = append(r.ops, SetOp(key, value))
 	r.b.Set(key, value)
 }
 
-// Delete records the changes while performing
+// Delete queues the change to be recorded on Write
 func (r *recorderBatch) Delete(key []byte) {
-	r.changes[string(key)] = nil
+	r.ops = append(r.ops, DelOp(key))
 	r.b.Delete(key)
 }
 
+// Write records all the queued changes and writes them out
 func (r *recorderBatch) Write() {
+	for _, op := range r.ops {
+		r.changes.record(r.db, op.key, op.value)
+	}
+	r.ops = nil
 	r.b.Write()
 }
+
+//----- keeping track of the changes
+
+// changes is a map from key to the current and the original value.
+// The original value is read the first time a key is modified.
+type changes struct {
+	values map[string][]byte
+	prev   map[string][]byte
+}
+
+func newChanges() *changes {
+	return &changes{
+		values: make(map[string][]byte),
+		prev:   make(map[string][]byte),
+	}
+}
+
+// record saves value as the new state of the key,
+// reading the previous value from db if this is the first write
+func (c *changes) record(db ReadOnlyKVStore, key, value []byte) {
+	k := string(key)
+	if _, ok := c.prev[k]; !ok {
+		c.prev[k] = db.Get(key)
+	}
+	c.values[k] = value
+}
+
+func (c *changes) changeset() Changeset {
+	res := make(Changeset, 0, len(c.values))
+	for k, v := range c.values {
+		res = append(res, Change{
+			Key:   []byte(k),
+			Prev:  c.prev[k],
+			Value: v,
+		})
+	}
+	res.sort()
+	return res
+}
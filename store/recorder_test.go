@@ -0,0 +1,91 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderChangeset(t *testing.T) {
+	base := MemStore()
+	base.Set([]byte("cash:alice"), []byte("10"))
+	base.Set([]byte("cash:bob"), []byte("20"))
+
+	db := NewRecordingStore(base)
+	// update an existing key twice, only the first value is old
+	db.Set([]byte("cash:alice"), []byte("9"))
+	db.Set([]byte("cash:alice"), []byte("8"))
+	// delete one
+	db.Delete([]byte("cash:bob"))
+	// create through a cache wrap
+	cache := db.(CacheableKVStore).CacheWrap()
+	cache.Set([]byte("escrow:1"), []byte("data"))
+	cache.Write()
+	// discarded changes are never recorded
+	cache = db.(CacheableKVStore).CacheWrap()
+	cache.Set([]byte("gconf:foo"), []byte("bar"))
+	cache.Discard()
+
+	r, ok := db.(Recorder)
+	require.True(t, ok)
+	changes := r.Changeset()
+
+	want := Changeset{
+		{Key: []byte("cash:alice"), Prev: []byte("10"), Value: []byte("8")},
+		{Key: []byte("cash:bob"), Prev: []byte("20"), Value: nil},
+		{Key: []byte("escrow:1"), Prev: nil, Value: []byte("data")},
+	}
+	assert.Equal(t, want, changes)
+	assert.Equal(t, []string{"cash", "escrow"}, changes.Buckets())
+	assert.False(t, changes[0].IsCreate())
+	assert.True(t, changes[1].IsDelete())
+	assert.True(t, changes[2].IsCreate())
+	assert.Len(t, r.KVPairs(), 3)
+
+	// rolling back restores the original state
+	changes.Rollback(base)
+	assert.Equal(t, []byte("10"), base.Get([]byte("cash:alice")))
+	assert.Equal(t, []byte("20"), base.Get([]byte("cash:bob")))
+	assert.Nil(t, base.Get([]byte("escrow:1")))
+}
+
+func TestRecorderBatch(t *testing.T) {
+	base := MemStore()
+	base.Set([]byte("foo:a"), []byte("old"))
+
+	// use a store that is not cacheable
+	db := NewRecordingStore(nonCacheable{base})
+	batch := db.NewBatch()
+	batch.Set([]byte("foo:a"), []byte("new"))
+	batch.Delete([]byte("foo:b"))
+
+	r := db.(Recorder)
+	assert.Len(t, r.Changeset(), 0, "nothing recorded before write")
+
+	batch.Write()
+	want := Changeset{
+		{Key: []byte("foo:a"), Prev: []byte("old"), Value: []byte("new")},
+		{Key: []byte("foo:b")},
+	}
+	assert.Equal(t, want, r.Changeset())
+	assert.Equal(t, []byte("new"), base.Get([]byte("foo:a")))
+}
+
+func TestKeyPrefix(t *testing.T) {
+	cases := map[string]string{
+		"cash:abc":            "cash",
+		"_i.escrow_sender:ab": "_i.escrow_sender",
+		"_wv:chainID":         "_wv",
+		"no-separator":        "",
+		":":                   "",
+	}
+	for key, want := range cases {
+		assert.Equal(t, want, KeyPrefix([]byte(key)), key)
+	}
+}
+
+// nonCacheable hides the CacheWrap method of a store
+type nonCacheable struct {
+	KVStore
+}
@@ -0,0 +1,263 @@
+package x
+
+import (
+	"math/big"
+)
+
+//-------------- Amount -----------------------
+
+const (
+	// MaxDecimals is the highest number of decimals of an Amount
+	MaxDecimals uint32 = 36
+	// MaxDigits is the longest value of an Amount, enough for
+	// any unsigned 256 bit integer
+	MaxDigits = 78
+
+	// CoinDecimals is the number of decimals of a Coin (see FracUnit),
+	// used by the Amount of every Coin
+	CoinDecimals uint32 = 9
+)
+
+// NewAmount creates an amount of value smallest units of a token
+// with the given decimals
+func NewAmount(value *big.Int, decimals uint32, ticker string) Amount {
+	return Amount{
+		Value:    value.String(),
+		Decimals: decimals,
+		Ticker:   ticker,
+	}
+}
+
+// Amount converts the coin into an Amount with 9 decimals,
+// so that it can be combined with amounts of any precision
+func (c Coin) Amount() Amount {
+	v := big.NewInt(c.Whole)
+	v.Mul(v, big.NewInt(FracUnit))
+	v.Add(v, big.NewInt(c.Fractional))
+	a := NewAmount(v, CoinDecimals, c.Ticker)
+	a.Issuer = c.Issuer
+	return a
+}
+
+// Coin converts the amount into a Coin. It returns an error if
+// the value cannot be represented by a Coin, either because it is
+// too large or because it is more precise than 10^-9.
+func (a Amount) Coin() (Coin, error) {
+	v, err := a.scaled(CoinDecimals)
+	if err != nil {
+		return Coin{}, err
+	}
+	// both have the sign of v, like a normalized Coin
+	whole, frac := new(big.Int).QuoRem(v, big.NewInt(FracUnit), new(big.Int))
+	if !whole.IsInt64() || whole.Int64() < MinInt || whole.Int64() > MaxInt {
+		return Coin{}, ErrAmountOutOfRange(a)
+	}
+	c := NewCoin(whole.Int64(), frac.Int64(), a.Ticker)
+	return c.WithIssuer(a.Issuer), nil
+}
+
+// WithIssuer sets the Issuer on an amount.
+// Returns new amount, so this can be chained on constructor
+func (a Amount) WithIssuer(issuer string) Amount {
+	a.Issuer = issuer
+	return a
+}
+
+// ID returns a unique identifier of the token, like Coin.ID
+func (a Amount) ID() string {
+	if a.Issuer == "" {
+		return a.Ticker
+	}
+	return a.Issuer + "/" + a.Ticker
+}
+
+// Int returns the value as an integer count of the smallest units.
+// An empty value is zero.
+func (a Amount) Int() (*big.Int, error) {
+	if a.Value == "" {
+		return new(big.Int), nil
+	}
+	// refuse to parse huge values
+	if len(a.digits()) > MaxDigits {
+		return nil, ErrAmountOutOfRange(a)
+	}
+	v, ok := new(big.Int).SetString(a.Value, 10)
+	if !ok || v.String() != a.Value {
+		return nil, ErrInvalidAmount(a, "value is not a decimal integer")
+	}
+	return v, nil
+}
+
+// scaled returns the value in units of 10^-decimals. It fails if the
+// value cannot be expressed with so few decimals without rounding.
+func (a Amount) scaled(decimals uint32) (*big.Int, error) {
+	if a.Decimals > MaxDecimals || decimals > MaxDecimals {
+		return nil, ErrInvalidAmount(a, "too many decimals")
+	}
+	v, err := a.Int()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case decimals > a.Decimals:
+		return v.Mul(v, pow10(decimals-a.Decimals)), nil
+	case decimals < a.Decimals:
+		q, r := new(big.Int).QuoRem(v, pow10(a.Decimals-decimals), new(big.Int))
+		if r.Sign() != 0 {
+			return nil, ErrInvalidAmount(a, "too precise")
+		}
+		return q, nil
+	}
+	return v, nil
+}
+
+// Add combines two amounts of the same token.
+// The result has the larger number of decimals of both.
+// Returns error if they are of different currencies, or if the
+// result would exceed MaxDigits.
+//
+// To subtract:
+//   a.Add(o.Negative())
+func (a Amount) Add(o Amount) (Amount, error) {
+	if !a.SameType(o) {
+		return Amount{}, ErrInvalidCurrency(a.Ticker, o.Ticker)
+	}
+	decimals := a.Decimals
+	if o.Decimals > decimals {
+		decimals = o.Decimals
+	}
+	x, err := a.scaled(decimals)
+	if err != nil {
+		return Amount{}, err
+	}
+	y, err := o.scaled(decimals)
+	if err != nil {
+		return Amount{}, err
+	}
+	res := NewAmount(x.Add(x, y), decimals, a.Ticker).WithIssuer(a.Issuer)
+	if len(res.digits()) > MaxDigits {
+		return Amount{}, ErrAmountOutOfRange(res)
+	}
+	return res, nil
+}
+
+// Negative returns the opposite amount
+//   a.Add(a.Negative()).IsZero() == true
+func (a Amount) Negative() Amount {
+	switch {
+	case a.IsZero():
+	case a.Value[0] == '-':
+		a.Value = a.Value[1:]
+	default:
+		a.Value = "-" + a.Value
+	}
+	return a
+}
+
+// Subtract given amount.
+func (a Amount) Subtract(o Amount) (Amount, error) {
+	return a.Add(o.Negative())
+}
+
+// Compare will check values of two amounts, without
+// inspecting the currency code, see Coin.Compare.
+// Invalid values compare as zero.
+//
+// Returns 1 if a is larger, -1 if o is larger, 0 if equal
+func (a Amount) Compare(o Amount) int {
+	decimals := a.Decimals
+	if o.Decimals > decimals {
+		decimals = o.Decimals
+	}
+	x, err := a.scaled(decimals)
+	if err != nil {
+		x = new(big.Int)
+	}
+	y, err := o.scaled(decimals)
+	if err != nil {
+		y = new(big.Int)
+	}
+	return x.Cmp(y)
+}
+
+// Equals returns true if both are the same token and value,
+// regardless of the decimals used to express it
+func (a Amount) Equals(o Amount) bool {
+	return a.SameType(o) && a.Compare(o) == 0
+}
+
+// IsZero returns true if the amount is 0
+func (a Amount) IsZero() bool {
+	return a.Value == "" || a.Value == "0"
+}
+
+// IsPositive returns true if the value is greater than 0
+func (a Amount) IsPositive() bool {
+	return !a.IsZero() && a.Value[0] != '-'
+}
+
+// IsNonNegative returns true if the value is 0 or higher
+func (a Amount) IsNonNegative() bool {
+	return a.IsZero() || a.Value[0] != '-'
+}
+
+// IsGTE returns true if a is same type and at least
+// as large as o.
+func (a Amount) IsGTE(o Amount) bool {
+	return a.SameType(o) && a.Compare(o) >= 0
+}
+
+// SameType returns true if they have the same currency
+func (a Amount) SameType(o Amount) bool {
+	return a.Ticker == o.Ticker &&
+		a.Issuer == o.Issuer
+}
+
+// WithinSigFigs returns true if the amount has no more than
+// sigFigs decimal places, like Coin.WithinSigFigs.
+// Invalid values are never within the limit.
+func (a Amount) WithinSigFigs(sigFigs int32) bool {
+	if sigFigs < 0 {
+		return false
+	}
+	if uint32(sigFigs) >= a.Decimals {
+		_, err := a.Int()
+		return err == nil
+	}
+	_, err := a.scaled(uint32(sigFigs))
+	return err == nil
+}
+
+// Clone provides an independent copy of an amount pointer
+func (a *Amount) Clone() *Amount {
+	c := *a
+	return &c
+}
+
+// Validate ensures that the value is a canonical decimal integer
+// in the valid range, the decimals are supported and the currency
+// code is valid. It accepts negative values, so you may want to
+// make other checks in your business logic
+func (a Amount) Validate() error {
+	if !IsCC(a.Ticker) {
+		return ErrInvalidCurrency(a.Ticker)
+	}
+	if a.Decimals > MaxDecimals {
+		return ErrInvalidAmount(a, "too many decimals")
+	}
+	_, err := a.Int()
+	return err
+}
+
+// digits returns the value without sign
+func (a Amount) digits() string {
+	if a.Value != "" && a.Value[0] == '-' {
+		return a.Value[1:]
+	}
+	return a.Value
+}
+
+// pow10 returns 10^n
+func pow10(n uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,191 @@
+package x
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// amt creates an amount from a decimal string, for readable tests
+func amt(value string, decimals uint32, ticker string) Amount {
+	return Amount{Value: value, Decimals: decimals, Ticker: ticker}
+}
+
+func TestAddAmount(t *testing.T) {
+	cases := []struct {
+		a, b Amount
+		res  Amount
+		bad  bool
+	}{
+		// plus and minus equals 0
+		{amt("17", 2, "DEF"), amt("-17", 2, "DEF"), amt("0", 2, "DEF"), false},
+		// different decimals use the larger one
+		{
+			amt("15", 1, "ETH"),
+			amt("1", 18, "ETH"),
+			amt("1500000000000000001", 18, "ETH"),
+			false,
+		},
+		// far above the Coin range
+		{
+			amt("999999999999999999999999", 0, "BIG"),
+			amt("1", 0, "BIG"),
+			amt("1000000000000000000000000", 0, "BIG"),
+			false,
+		},
+		// wrong types
+		{amt("1", 0, "FOO"), amt("1", 0, "BAR"), Amount{}, true},
+		// wrong issuer
+		{amt("1", 0, "FOO").WithIssuer("chain-1"), amt("1", 0, "FOO"), Amount{}, true},
+		// invalid value
+		{amt("01", 0, "FOO"), amt("1", 0, "FOO"), Amount{}, true},
+		// overflow
+		{
+			amt(strings.Repeat("9", MaxDigits), 0, "SEE"),
+			amt("1", 0, "SEE"),
+			Amount{},
+			true,
+		},
+	}
+
+	for idx, tc := range cases {
+		t.Run(fmt.Sprintf("case-%d", idx), func(t *testing.T) {
+			a, err := tc.a.Add(tc.b)
+			if tc.bad {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.res, a)
+		})
+	}
+}
+
+func TestCompareAmount(t *testing.T) {
+	cases := []struct {
+		a, b     Amount
+		expected cmp
+		gte      bool
+	}{
+		{amt("1", 0, "ETH"), amt("1000000000000000000", 18, "ETH"), zero, true},
+		{amt("1", 18, "ETH"), amt("0", 0, "ETH"), pos, true},
+		{amt("-1", 18, "ETH"), amt("", 0, "ETH"), neg, false},
+		{amt("5", 0, "ETH"), amt("5", 0, "BTC"), zero, false},
+	}
+
+	for idx, tc := range cases {
+		t.Run(fmt.Sprintf("case-%d", idx), func(t *testing.T) {
+			assert.Equal(t, int(tc.expected), tc.a.Compare(tc.b))
+			assert.Equal(t, tc.gte, tc.a.IsGTE(tc.b))
+		})
+	}
+}
+
+func TestValidAmount(t *testing.T) {
+	cases := []struct {
+		a     Amount
+		valid bool
+	}{
+		{amt("1234567890123456789012345678901234567890", 18, "ETH"), true},
+		{amt("-12", 2, "FOO"), true},
+		{amt("", 0, "FOO"), true},
+		{amt("12", 2, "foo"), false},
+		{amt("012", 2, "FOO"), false},
+		{amt("+12", 2, "FOO"), false},
+		{amt("-0", 2, "FOO"), false},
+		{amt("1.5", 2, "FOO"), false},
+		{amt("1", MaxDecimals+1, "FOO"), false},
+		{amt(strings.Repeat("1", MaxDigits+1), 0, "FOO"), false},
+	}
+
+	for idx, tc := range cases {
+		t.Run(fmt.Sprintf("case-%d", idx), func(t *testing.T) {
+			err := tc.a.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAmountCoinConversion(t *testing.T) {
+	coin := NewCoin(-3, -20, "FOO").WithIssuer("chain")
+	a := coin.Amount()
+	assert.Equal(t, amt("-3000000020", 9, "FOO").WithIssuer("chain"), a)
+	back, err := a.Coin()
+	require.NoError(t, err)
+	assert.Equal(t, coin, back)
+
+	// fewer decimals are scaled
+	c, err := amt("125", 2, "FOO").Coin()
+	require.NoError(t, err)
+	assert.Equal(t, NewCoin(1, 250000000, "FOO"), c)
+
+	// trailing zeros of more decimals are dropped
+	c, err = amt("1500000000000000000", 18, "ETH").Coin()
+	require.NoError(t, err)
+	assert.Equal(t, NewCoin(1, 500000000, "ETH"), c)
+
+	// too precise
+	_, err = amt("1", 18, "ETH").Coin()
+	assert.Error(t, err)
+
+	// too large
+	huge := NewAmount(new(big.Int).Exp(big.NewInt(10), big.NewInt(15), nil), 0, "FOO")
+	_, err = huge.Coin()
+	assert.True(t, IsInvalidCoinErr(err))
+}
+
+func TestAmounts(t *testing.T) {
+	var as Amounts
+	var err error
+	for _, a := range []Amount{
+		amt("5", 0, "FOO"),
+		amt("1", 18, "ETH"),
+		amt("2", 1, "BAR"),
+		amt("-5", 0, "FOO"),
+		amt("1", 0, "ETH"),
+	} {
+		as, err = as.Add(a)
+		require.NoError(t, err)
+	}
+	require.NoError(t, as.Validate())
+	want := Amounts{
+		&Amount{Value: "2", Decimals: 1, Ticker: "BAR"},
+		&Amount{Value: "1000000000000000001", Decimals: 18, Ticker: "ETH"},
+	}
+	assert.True(t, want.Equals(as), "%v", as)
+	assert.True(t, as.IsPositive())
+	assert.True(t, as.Contains(amt("1", 0, "ETH")))
+	assert.False(t, as.Contains(amt("2", 0, "ETH")))
+	assert.False(t, as.Contains(amt("1", 0, "FOO")))
+
+	unsorted := Amounts{want[1], want[0]}
+	assert.Error(t, unsorted.Validate())
+
+	coins := Coins{&Coin{Whole: 2, Ticker: "BAR"}}
+	assert.True(t, coins.ContainsAmount(amt("15", 1, "BAR")))
+	assert.False(t, coins.ContainsAmount(amt("21", 1, "BAR")))
+	coins, err = coins.AddAmount(amt("-5", 1, "BAR"))
+	require.NoError(t, err)
+	assert.Equal(t, Coins{&Coin{Whole: 1, Fractional: 500000000, Ticker: "BAR"}}, coins)
+	_, err = coins.AddAmount(amt("1", 18, "BAR"))
+	assert.Error(t, err)
+}
+
+func TestAmountWithinSigFigs(t *testing.T) {
+	a := amt("1230000000000000000", 18, "ETH")
+	assert.False(t, a.WithinSigFigs(0))
+	assert.False(t, a.WithinSigFigs(1))
+	assert.True(t, a.WithinSigFigs(2))
+	assert.True(t, a.WithinSigFigs(18))
+	assert.True(t, amt("-5", 0, "ETH").WithinSigFigs(0))
+	assert.False(t, amt("1", 18, "ETH").WithinSigFigs(9))
+	assert.False(t, amt("1.5", 0, "ETH").WithinSigFigs(9))
+}
@@ -0,0 +1,206 @@
+package x
+
+import "strings"
+
+//--------------------- Amounts -------------------------
+
+// Amounts is a set of arbitrary precision amounts, at most one for
+// every token, sorted by ID. It works like Coins.
+type Amounts []*Amount
+
+// Amounts converts all coins into amounts
+func (cs Coins) Amounts() Amounts {
+	if cs == nil {
+		return nil
+	}
+	res := make(Amounts, len(cs))
+	for i, c := range cs {
+		a := c.Amount()
+		res[i] = &a
+	}
+	return res
+}
+
+// AddAmount modifies the Coins, to increase the holdings by a.
+// It fails if a (or the resulting holding) cannot be represented
+// by a Coin, use Amounts for those.
+func (cs Coins) AddAmount(a Amount) (Coins, error) {
+	c, err := a.Coin()
+	if err != nil {
+		return nil, err
+	}
+	return cs.Add(c)
+}
+
+// ContainsAmount returns true if there is at least amount a
+// in the Coins
+func (cs Coins) ContainsAmount(a Amount) bool {
+	has, _ := cs.findCoin(a.ID())
+	if has == nil {
+		return false
+	}
+	return has.Amount().IsGTE(a)
+}
+
+// Split separates the amounts that can be represented by a Coin from
+// those that cannot (too large or too precise). This allows to store
+// balances as Coins whenever possible, so they stay readable by code
+// that does not know about Amounts.
+func (as Amounts) Split() (Coins, Amounts) {
+	var coins Coins
+	var rest Amounts
+	for _, a := range as {
+		c, err := a.Coin()
+		if err != nil {
+			rest = append(rest, a.Clone())
+			continue
+		}
+		coins = append(coins, &c)
+	}
+	return coins, rest
+}
+
+// Clone returns a copy that can be safely modified
+func (as Amounts) Clone() Amounts {
+	if as == nil {
+		return nil
+	}
+	res := make(Amounts, len(as))
+	for i, a := range as {
+		res[i] = a.Clone()
+	}
+	return res
+}
+
+// Add modifies the Amounts, to increase the holdings by a
+func (as Amounts) Add(a Amount) (Amounts, error) {
+	// We ignore zero values
+	if a.IsZero() {
+		return as, nil
+	}
+
+	has, i := as.findAmount(a.ID())
+	// add to existing amount
+	if has != nil {
+		sum, err := has.Add(a)
+		if err != nil {
+			return nil, err
+		}
+		// if the result is zero, remove this currency
+		if sum.IsZero() {
+			return append(as[:i], as[i+1:]...), nil
+		}
+		as[i] = &sum
+		return as, nil
+	}
+	// insert at the proper position (with one alloc)
+	res := append(as, nil)
+	copy(res[i+1:], res[i:])
+	res[i] = &a
+	return res, nil
+}
+
+// Subtract modifies the Amounts, to decrease the holdings by a.
+// The resulting Amounts may have negative values
+func (as Amounts) Subtract(a Amount) (Amounts, error) {
+	return as.Add(a.Negative())
+}
+
+// Combine will create a new Amounts adding all the amounts
+// of as and o together.
+func (as Amounts) Combine(o Amounts) (Amounts, error) {
+	var err error
+	res := as.Clone()
+	for _, a := range o {
+		res, err = res.Add(*a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Contains returns true if there is at least that much
+// of the token in the Amounts
+func (as Amounts) Contains(a Amount) bool {
+	has, _ := as.findAmount(a.ID())
+	if has == nil {
+		return false
+	}
+	return has.IsGTE(a)
+}
+
+// Get returns the amount of the token with the given ID,
+// or nil if there is none
+func (as Amounts) Get(id string) *Amount {
+	has, _ := as.findAmount(id)
+	return has
+}
+
+// findAmount returns an amount and index that have this
+// token ID, see Coins.findCoin
+func (as Amounts) findAmount(id string) (*Amount, int) {
+	for i, a := range as {
+		switch strings.Compare(id, a.ID()) {
+		case -1:
+			return nil, i
+		case 0:
+			return a, i
+		}
+	}
+	// hit the end, must append
+	return nil, len(as)
+}
+
+// IsEmpty returns if nothing is in the Amounts
+func (as Amounts) IsEmpty() bool {
+	return len(as) == 0
+}
+
+// IsPositive returns true if there is at least one amount
+// and all amounts are positive
+func (as Amounts) IsPositive() bool {
+	if as.IsEmpty() {
+		return false
+	}
+	for _, a := range as {
+		if !a.IsPositive() {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if both Amounts contain the same values
+func (as Amounts) Equals(o Amounts) bool {
+	if len(as) != len(o) {
+		return false
+	}
+	for i := range as {
+		if !as[i].Equals(*o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate requires that all amounts are sorted by ID, with
+// no duplicates, and that each amount is valid in it's own right
+//
+// Zero amounts should not be present
+func (as Amounts) Validate() error {
+	last := ""
+	for i, a := range as {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if a.IsZero() {
+			return ErrInvalidWallet("Zero amounts")
+		}
+		if i > 0 && a.ID() <= last {
+			return ErrInvalidWallet("Not sorted")
+		}
+		last = a.ID()
+	}
+	return nil
+}
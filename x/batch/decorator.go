@@ -6,9 +6,12 @@ transaction
 package batch
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x"
 	"github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/common"
 )
@@ -62,6 +65,17 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 
 	msgList, _ := batchMsg.MsgList()
 
+	// validate all messages, so that all problems are reported at once
+	var errs error
+	for i, msg := range msgList {
+		if v, ok := msg.(x.Validater); ok {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("msgs.%d", i), v.Validate()))
+		}
+	}
+	if errs != nil {
+		return res, errs
+	}
+
 	checks := make([]weave.CheckResult, len(msgList))
 	for i, msg := range msgList {
 		checks[i], err = next.Check(ctx, store, &BatchTx{Tx: tx, msg: msg})
@@ -70,7 +84,7 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		}
 	}
 	res = d.combineChecks(checks)
-	return res, err
+	return res, nil
 
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	weaveerrors "github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/batch"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/mock"
@@ -192,6 +193,27 @@ func TestDecorator(t *testing.T) {
 				helper.AssertExpectations(t)
 				msg.AssertExpectations(t)
 			})
+
+			Convey("Invalid messages", func() {
+				expectedErr := errors.New("asd")
+				helper.On("GetMsg").Return(msg, nil).Times(1)
+				msg.On("Validate").Return(nil).Times(1)
+				invalid, valid := &mockMsg{}, &mockMsg{}
+				invalid.On("Validate").Return(expectedErr).Times(2)
+				valid.On("Validate").Return(nil).Times(1)
+				msg.On("MsgList").Return([]weave.Msg{invalid, valid, invalid}, nil).Times(1)
+
+				// the validation errors of all messages are reported at once,
+				// nothing is checked
+				_, err := decorator.Check(nil, nil, helper, helper)
+				So(err, ShouldHaveSameTypeAs, &weaveerrors.MultiError{})
+				So(err.(*weaveerrors.MultiError).Errors(), ShouldHaveLength, 2)
+				So(err.Error(), ShouldStartWith, "2 errors: msgs.0: asd; msgs.2: asd")
+				helper.AssertExpectations(t)
+				msg.AssertExpectations(t)
+				invalid.AssertExpectations(t)
+				valid.AssertExpectations(t)
+			})
 		})
 	})
 }
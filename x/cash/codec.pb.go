@@ -34,8 +34,12 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // Set may contain Coin of many different currencies.
 // It handles adding and subtracting sets of currencies.
+//
+// Balances that cannot be represented by a Coin (too large or
+// too precise) are held in amounts. A token is never in both lists.
 type Set struct {
-	Coins []*x.Coin `protobuf:"bytes,1,rep,name=coins" json:"coins,omitempty"`
+	Coins   []*x.Coin   `protobuf:"bytes,1,rep,name=coins" json:"coins,omitempty"`
+	Amounts []*x.Amount `protobuf:"bytes,2,rep,name=amounts" json:"amounts,omitempty"`
 }
 
 func (m *Set) Reset()                    { *m = Set{} }
@@ -50,6 +54,13 @@ func (m *Set) GetCoins() []*x.Coin {
 	return nil
 }
 
+func (m *Set) GetAmounts() []*x.Amount {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
 // SendMsg is a request to move these coins from the given
 // source to the given destination address.
 // memo is an optional human-readable message
@@ -63,6 +74,10 @@ type SendMsg struct {
 	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
 	// max length 64 bytes
 	Ref []byte `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty"`
+	// precise_amount can be used instead of amount to send
+	// tokens that a Coin cannot represent. Exactly one of
+	// both must be set.
+	PreciseAmount *x.Amount `protobuf:"bytes,6,opt,name=precise_amount,json=preciseAmount" json:"precise_amount,omitempty"`
 }
 
 func (m *SendMsg) Reset()                    { *m = SendMsg{} }
@@ -105,6 +120,13 @@ func (m *SendMsg) GetRef() []byte {
 	return nil
 }
 
+func (m *SendMsg) GetPreciseAmount() *x.Amount {
+	if m != nil {
+		return m.PreciseAmount
+	}
+	return nil
+}
+
 // FeeInfo records who pays what fees to have this
 // message processed
 type FeeInfo struct {
@@ -163,6 +185,18 @@ func (m *Set) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Amounts) > 0 {
+		for _, msg := range m.Amounts {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -215,6 +249,16 @@ func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ref)))
 		i += copy(dAtA[i:], m.Ref)
 	}
+	if m.PreciseAmount != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PreciseAmount.Size()))
+		n2, err := m.PreciseAmount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -243,11 +287,11 @@ func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n2, err := m.Fees.MarshalTo(dAtA[i:])
+		n3, err := m.Fees.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	return i, nil
 }
@@ -270,6 +314,12 @@ func (m *Set) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -296,6 +346,10 @@ func (m *SendMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.PreciseAmount != nil {
+		l = m.PreciseAmount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -386,6 +440,37 @@ func (m *Set) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, &x.Amount{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -591,6 +676,39 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 				m.Ref = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreciseAmount == nil {
+				m.PreciseAmount = &x.Amount{}
+			}
+			if err := m.PreciseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -834,21 +952,24 @@ var (
 func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0xb1, 0x4e, 0x84, 0x40,
-	0x10, 0x86, 0x5d, 0x81, 0x23, 0x8e, 0x16, 0x97, 0x8d, 0xc5, 0x46, 0x23, 0x12, 0x62, 0x0c, 0x8d,
-	0x90, 0x68, 0x6b, 0xa5, 0x89, 0x89, 0x85, 0x0d, 0xf7, 0x04, 0xdc, 0x32, 0xdc, 0x51, 0xb0, 0x73,
-	0xd9, 0xdd, 0x43, 0x7c, 0x0b, 0x1f, 0xcb, 0xd2, 0x47, 0x30, 0xf8, 0x22, 0x17, 0x96, 0x2b, 0xe8,
-	0xfe, 0xf9, 0x32, 0xf3, 0x7f, 0x19, 0xe0, 0x7d, 0x2e, 0x4b, 0xb3, 0xcd, 0x25, 0x55, 0x28, 0xb3,
-	0x9d, 0x26, 0x4b, 0xdc, 0x1f, 0xc9, 0xd5, 0xfd, 0xa6, 0xb1, 0xdb, 0xfd, 0x3a, 0x93, 0xd4, 0xe6,
-	0x0d, 0x75, 0x0f, 0xa4, 0x30, 0xff, 0xc4, 0xb2, 0xc3, 0xbc, 0x9f, 0x6f, 0x27, 0x77, 0xe0, 0xad,
-	0xd0, 0xf2, 0x1b, 0x08, 0x24, 0x35, 0xca, 0x08, 0x16, 0x7b, 0xe9, 0xf9, 0x63, 0x98, 0xf5, 0xd9,
-	0x2b, 0x35, 0xaa, 0x98, 0x68, 0xd2, 0x41, 0xb8, 0x42, 0x55, 0x7d, 0x98, 0x0d, 0x5f, 0x82, 0x67,
-	0xb4, 0x14, 0x2c, 0x66, 0xe9, 0x45, 0x31, 0x46, 0xce, 0xc1, 0xaf, 0xd0, 0x58, 0x71, 0xea, 0x90,
-	0xcb, 0xfc, 0x16, 0x16, 0x65, 0x4b, 0x7b, 0x65, 0x85, 0x17, 0xb3, 0x79, 0xe1, 0x11, 0x8f, 0x47,
-	0x2d, 0xb6, 0x24, 0xfc, 0x98, 0xa5, 0x67, 0x85, 0xcb, 0x63, 0xb5, 0xc6, 0x5a, 0x04, 0x53, 0xb5,
-	0xc6, 0x3a, 0x79, 0x86, 0xf0, 0x0d, 0xf1, 0x5d, 0xd5, 0xc4, 0x2f, 0x21, 0xd8, 0x95, 0x5f, 0xa8,
-	0x8f, 0xe6, 0x69, 0xe0, 0xd7, 0xe0, 0xd7, 0x88, 0xc6, 0xb9, 0x67, 0x16, 0x07, 0x5f, 0x96, 0x3f,
-	0x43, 0xc4, 0x7e, 0x87, 0x88, 0xfd, 0x0d, 0x11, 0xfb, 0xfe, 0x8f, 0x4e, 0xd6, 0x0b, 0xf7, 0xf4,
-	0xd3, 0x21, 0x00, 0x00, 0xff, 0xff, 0xce, 0x64, 0x21, 0xca, 0x38, 0x01, 0x00, 0x00,
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4e, 0xf3, 0x30,
+	0x10, 0x85, 0x7f, 0x37, 0x69, 0xa3, 0xce, 0x0f, 0xa8, 0xb2, 0x58, 0x58, 0x20, 0x42, 0x14, 0x24,
+	0x94, 0x0d, 0x0e, 0x82, 0x2d, 0x1b, 0x40, 0x42, 0xea, 0x82, 0x4d, 0x7a, 0x00, 0x94, 0x3a, 0x93,
+	0x36, 0x8b, 0xd8, 0x91, 0x9d, 0x96, 0x70, 0x0b, 0x8e, 0xc1, 0x51, 0x58, 0x72, 0x04, 0x14, 0x2e,
+	0x82, 0xe2, 0x04, 0xa9, 0xdd, 0xbd, 0xf9, 0xfc, 0xe6, 0xf9, 0x69, 0x80, 0x36, 0xb1, 0x48, 0xcd,
+	0x3a, 0x16, 0x2a, 0x43, 0xc1, 0x2b, 0xad, 0x6a, 0x45, 0xdd, 0x8e, 0x9c, 0x5c, 0xae, 0x8a, 0x7a,
+	0xbd, 0x59, 0x72, 0xa1, 0xca, 0xb8, 0x50, 0xdb, 0x2b, 0x25, 0x31, 0x7e, 0xc5, 0x74, 0x8b, 0x71,
+	0xb3, 0xeb, 0x0e, 0xe7, 0xe0, 0x2c, 0xb0, 0xa6, 0x67, 0x30, 0x16, 0xaa, 0x90, 0x86, 0x91, 0xc0,
+	0x89, 0xfe, 0xdf, 0x78, 0xbc, 0xe1, 0x8f, 0xaa, 0x90, 0x49, 0x4f, 0xe9, 0x05, 0x78, 0x69, 0xa9,
+	0x36, 0xb2, 0x36, 0x6c, 0x64, 0x0d, 0x53, 0xde, 0xf0, 0x7b, 0x4b, 0x92, 0xbf, 0x97, 0xf0, 0x83,
+	0x80, 0xb7, 0x40, 0x99, 0x3d, 0x9b, 0x15, 0x9d, 0x81, 0x63, 0xb4, 0x60, 0x24, 0x20, 0xd1, 0x41,
+	0xd2, 0x49, 0x4a, 0xc1, 0xcd, 0xd0, 0xd4, 0x6c, 0x64, 0x91, 0xd5, 0xf4, 0x1c, 0x26, 0xfd, 0x32,
+	0x73, 0x02, 0xb2, 0xfb, 0xed, 0x80, 0xbb, 0xa5, 0x12, 0x4b, 0xc5, 0xdc, 0x80, 0x44, 0xd3, 0xc4,
+	0xea, 0x2e, 0x5a, 0x63, 0xce, 0xc6, 0x7d, 0xb4, 0xc6, 0x9c, 0x5e, 0xc3, 0x51, 0xa5, 0x51, 0x14,
+	0x06, 0x5f, 0x86, 0xb8, 0x49, 0x40, 0xf6, 0x4b, 0x1e, 0x0e, 0x86, 0x7e, 0x0c, 0xef, 0xc0, 0x7b,
+	0x42, 0x9c, 0xcb, 0x5c, 0xd1, 0x63, 0x18, 0x57, 0xe9, 0x1b, 0xea, 0xa1, 0x6b, 0x3f, 0xd0, 0x53,
+	0x70, 0x73, 0x44, 0xc3, 0x46, 0xfb, 0xbd, 0x2c, 0x7c, 0x98, 0x7d, 0xb6, 0x3e, 0xf9, 0x6a, 0x7d,
+	0xf2, 0xdd, 0xfa, 0xe4, 0xfd, 0xc7, 0xff, 0xb7, 0x9c, 0xd8, 0x63, 0xde, 0xfe, 0x0e, 0x00, 0x0a,
+	0xc7, 0x3d, 0x5c, 0x90, 0x01, 0x00, 0x00,
 }
@@ -16,12 +16,56 @@ type Controller interface {
 		amount x.Coin) error
 }
 
+// AmountController is a Controller that can also move and issue
+// values that a Coin cannot represent. Use MoveAmount and IssueAmount
+// to support any Controller.
+type AmountController interface {
+	Controller
+	MoveAmount(store weave.KVStore, src weave.Address,
+		dest weave.Address, amount x.Amount) error
+	IssueAmount(store weave.KVStore, dest weave.Address,
+		amount x.Amount) error
+}
+
+// MoveAmount moves the given amount from src to dest with the
+// controller. If it is not an AmountController, the amount is moved
+// as a Coin, failing if a Coin cannot represent it.
+func MoveAmount(ctrl Controller, store weave.KVStore,
+	src weave.Address, dest weave.Address, amount x.Amount) error {
+
+	if ac, ok := ctrl.(AmountController); ok {
+		return ac.MoveAmount(store, src, dest, amount)
+	}
+	c, err := amount.Coin()
+	if err != nil {
+		return err
+	}
+	return ctrl.MoveCoins(store, src, dest, c)
+}
+
+// IssueAmount adds the given amount to the destination address with
+// the controller, like MoveAmount.
+func IssueAmount(ctrl Controller, store weave.KVStore,
+	dest weave.Address, amount x.Amount) error {
+
+	if ac, ok := ctrl.(AmountController); ok {
+		return ac.IssueAmount(store, dest, amount)
+	}
+	c, err := amount.Coin()
+	if err != nil {
+		return err
+	}
+	return ctrl.IssueCoins(store, dest, c)
+}
+
 // BaseController is a simple implementation of controller
 // wallet must return something that supports AsSet
 type BaseController struct {
 	bucket WalletBucket
 }
 
+var _ AmountController = BaseController{}
+
 // NewController returns a basic controller implementation
 func NewController(bucket WalletBucket) BaseController {
 	ValidateWalletBucket(bucket)
@@ -46,7 +90,7 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if sender == nil {
 		return ErrEmptyAccount(src)
 	}
-	if !AsCoins(sender).Contains(amount) {
+	if !Balance(AsCoinage(sender)).Contains(amount.Amount()) {
 		return ErrInsufficientFunds()
 	}
 	err = Subtract(AsCoinage(sender), amount)
@@ -89,3 +133,63 @@ func (c BaseController) IssueCoins(store weave.KVStore,
 
 	return c.bucket.Save(store, recipient)
 }
+
+// MoveAmount moves the given amount from src to dest, like
+// MoveCoins. The amount may be too large or too precise for
+// a Coin, as long as the wallets support holding it.
+func (c BaseController) MoveAmount(store weave.KVStore,
+	src weave.Address, dest weave.Address, amount x.Amount) error {
+
+	if !amount.IsPositive() {
+		return ErrInvalidAmount("Non-positive SendMsg")
+	}
+
+	// load sender, subtract funds, and save
+	sender, err := c.bucket.Get(store, src)
+	if err != nil {
+		return err
+	}
+	if sender == nil {
+		return ErrEmptyAccount(src)
+	}
+	if !Balance(AsCoinage(sender)).Contains(amount) {
+		return ErrInsufficientFunds()
+	}
+	err = SubtractAmount(AsCoinage(sender), amount)
+	if err != nil {
+		return err
+	}
+	err = c.bucket.Save(store, sender)
+	if err != nil {
+		return err
+	}
+
+	// load/create recipient, add funds, save
+	recipient, err := c.bucket.GetOrCreate(store, dest)
+	if err != nil {
+		return err
+	}
+	err = AddAmount(AsCoinage(recipient), amount)
+	if err != nil {
+		return err
+	}
+	return c.bucket.Save(store, recipient)
+}
+
+// IssueAmount adds the given amount to the destination
+// address, like IssueCoins. Fails if the wallet cannot hold
+// the resulting balance.
+func (c BaseController) IssueAmount(store weave.KVStore,
+	dest weave.Address, amount x.Amount) error {
+
+	recipient, err := c.bucket.GetOrCreate(store, dest)
+	if err != nil {
+		return err
+	}
+	err = AddAmount(AsCoinage(recipient), amount)
+	if err != nil {
+		return err
+	}
+
+	return c.bucket.Save(store, recipient)
+}
@@ -91,13 +91,13 @@ func TestIssueCoins(t *testing.T) {
 				{addr2, true, nil, nil},
 			},
 		},
-		// set back to zero
+		// exceeding the coin range is held as amount
 		{
 			issue: []issueCmd{
 				{addr, total, false},
-				{addr, x.NewCoin(x.MaxInt, 0, "FOO"), true}},
+				{addr, x.NewCoin(x.MaxInt, 0, "FOO"), false}},
 			check: []checkCmd{
-				{addr, false, []x.Coin{total}, []x.Coin{plus, other}},
+				{addr, true, nil, nil},
 				{addr2, true, nil, nil},
 			},
 		},
@@ -254,3 +254,108 @@ func TestMoveCoins(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveAmount(t *testing.T) {
+	var helpers x.TestHelpers
+
+	_, perm := helpers.MakeKey()
+	_, perm2 := helpers.MakeKey()
+	addr := perm.Address()
+	addr2 := perm2.Address()
+
+	controller := NewController(NewBucket())
+	bucket := NewBucket()
+	kv := store.MemStore()
+
+	balance := func(addr weave.Address) x.Amounts {
+		obj, err := bucket.Get(kv, addr)
+		require.NoError(t, err)
+		return Balance(AsCoinage(obj))
+	}
+
+	// 18 decimals and above the Coin range are held as amount
+	eth := x.Amount{Value: "1000000000000000000000000000000001", Decimals: 18, Ticker: "ETH"}
+	require.NoError(t, controller.IssueAmount(kv, addr, eth))
+	require.NoError(t, controller.IssueCoins(kv, addr, x.NewCoin(5, 0, "FOO")))
+	assert.True(t, balance(addr).Contains(eth))
+
+	// moving coins of a precise token uses amounts
+	require.NoError(t, controller.MoveCoins(kv, addr, addr2, x.NewCoin(7, 0, "ETH")))
+	wallet := getWallet(kv, addr)
+	assert.Equal(t, x.Coins{&x.Coin{Whole: 5, Ticker: "FOO"}}, wallet)
+
+	// a remaining balance that fits is stored as coin again
+	rest := x.Amount{Value: "999999999999991000000000000000001", Decimals: 18, Ticker: "ETH"}
+	require.NoError(t, controller.MoveAmount(kv, addr, addr2, rest))
+	wallet = getWallet(kv, addr)
+	assert.Equal(t, x.Coins{
+		&x.Coin{Whole: 2, Ticker: "ETH"},
+		&x.Coin{Whole: 5, Ticker: "FOO"},
+	}, wallet)
+	received := x.Amount{Value: "999999999999998000000000000000001", Decimals: 18, Ticker: "ETH"}
+	assert.True(t, balance(addr2).Contains(received))
+	assert.Nil(t, getWallet(kv, addr2))
+
+	// cannot move more than held, or non-positive amounts
+	err := controller.MoveAmount(kv, addr, addr2, x.Amount{Value: "3", Decimals: 0, Ticker: "ETH"})
+	assert.True(t, IsInsufficientFundsErr(err))
+	err = controller.MoveAmount(kv, addr, addr2, x.Amount{Value: "-1", Ticker: "ETH"})
+	assert.True(t, IsInvalidAmountErr(err))
+}
+
+func TestMoveCoinsOutOfRange(t *testing.T) {
+	var helpers x.TestHelpers
+
+	_, perm := helpers.MakeKey()
+	_, perm2 := helpers.MakeKey()
+	addr := perm.Address()
+	addr2 := perm2.Address()
+
+	controller := NewController(NewBucket())
+	kv := store.MemStore()
+
+	max := x.NewCoin(x.MaxInt, 0, "FOO")
+	require.NoError(t, controller.IssueCoins(kv, addr, max))
+	require.NoError(t, controller.IssueCoins(kv, addr2, max))
+
+	// the recipient balance exceeds the coin range
+	require.NoError(t, controller.MoveCoins(kv, addr, addr2, x.NewCoin(1, 0, "FOO")))
+	obj, err := NewBucket().Get(kv, addr2)
+	require.NoError(t, err)
+	want := x.Amount{Value: "1000000000000000", Ticker: "FOO"}
+	assert.True(t, Balance(AsCoinage(obj)).Contains(want))
+	assert.Nil(t, getWallet(kv, addr2))
+
+	// and is stored as coin again once it fits
+	require.NoError(t, controller.MoveCoins(kv, addr2, addr, x.NewCoin(2, 0, "FOO")))
+	assert.Equal(t, x.Coins{&x.Coin{Whole: x.MaxInt - 1, Ticker: "FOO"}}, getWallet(kv, addr2))
+}
+
+// coinController supports coins only
+type coinController struct {
+	Controller
+}
+
+func TestMoveAmountWithController(t *testing.T) {
+	var helpers x.TestHelpers
+
+	_, perm := helpers.MakeKey()
+	_, perm2 := helpers.MakeKey()
+	addr := perm.Address()
+	addr2 := perm2.Address()
+
+	controller := coinController{NewController(NewBucket())}
+	kv := store.MemStore()
+
+	// amounts that fit a coin are moved as coins
+	require.NoError(t, IssueAmount(controller, kv, addr, x.Amount{Value: "5", Ticker: "FOO"}))
+	require.NoError(t, MoveAmount(controller, kv, addr, addr2, x.Amount{Value: "2000000000", Decimals: 9, Ticker: "FOO"}))
+	assert.Equal(t, x.Coins{&x.Coin{Whole: 3, Ticker: "FOO"}}, getWallet(kv, addr))
+	assert.Equal(t, x.Coins{&x.Coin{Whole: 2, Ticker: "FOO"}}, getWallet(kv, addr2))
+
+	// others are rejected
+	err := MoveAmount(controller, kv, addr, addr2, x.Amount{Value: "1", Decimals: 18, Ticker: "FOO"})
+	assert.True(t, x.IsInvalidCoinErr(err))
+	err = IssueAmount(controller, kv, addr, x.Amount{Value: "1000000000000000", Ticker: "FOO"})
+	assert.True(t, x.IsInvalidCoinErr(err))
+}
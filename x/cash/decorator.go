@@ -33,6 +33,35 @@ const (
 	GconfMinimalFee       = "cash:minimal_fee"
 )
 
+func init() {
+	gconf.Register(GconfCollectorAddress, gconf.Schema{
+		Type: weave.Address(nil),
+		Validate: func(v interface{}) error {
+			return v.(weave.Address).Validate()
+		},
+	})
+	gconf.Register(GconfMinimalFee, gconf.Schema{
+		Default:  x.Coin{}, // no fee
+		Validate: validateMinimalFee,
+	})
+}
+
+// validateMinimalFee accepts a zero coin without a ticker (no fee
+// required) or a valid non-negative coin
+func validateMinimalFee(v interface{}) error {
+	fee := v.(x.Coin)
+	if fee.IsZero() && fee.Ticker == "" {
+		return nil
+	}
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	if !fee.IsNonNegative() {
+		return ErrInvalidAmount("Negative minimal fee")
+	}
+	return nil
+}
+
 var _ weave.Decorator = FeeDecorator{}
 
 // NewFeeDecorator returns a FeeDecorator with the given
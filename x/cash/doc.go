@@ -6,6 +6,10 @@ There is no logic in the coins (tokens), except that the balance
 of any coin may not go below zero. Thus, this implementation is
 referred to as cash. Simple and safe.
 
+Balances are kept as x.Coin whenever possible. Tokens that a Coin
+cannot represent (more than 9 decimals or above 10^15) are held as
+x.Amount and moved with an AmountController.
+
 In the future, there should be more implementations that
 support sending and issuing tokens with much more logic inside.
 */
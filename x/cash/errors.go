@@ -1,15 +1,13 @@
 package cash
 
 import (
-	"fmt"
-
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x"
 )
 
 // ABCI Response Codes
-// x/coins reserves 30 ~ 39.
+// x/coins reserves 30 ~ 39, cash uses 32 ~ 39.
 const (
 	CodeInsufficientFees  uint32 = 32
 	CodeInsufficientFunds        = 33
@@ -19,45 +17,46 @@ const (
 )
 
 var (
-	errInsufficientFees  = fmt.Errorf("Insufficient fees")
-	errInsufficientFunds = fmt.Errorf("Insufficient funds")
-	errInvalidAmount     = fmt.Errorf("Invalid amount")
-	errInvalidMemo       = fmt.Errorf("Invalid memo")
-	errEmptyAccount      = fmt.Errorf("Account empty")
+	codes = errors.RegisterRange("cash", 32, 39)
+
+	InsufficientFeesErr  = codes.Register(CodeInsufficientFees, "insufficient fees")
+	InsufficientFundsErr = codes.Register(CodeInsufficientFunds, "insufficient funds")
+	InvalidAmountErr     = codes.Register(CodeInvalidAmount, "invalid amount")
+	InvalidMemoErr       = codes.Register(CodeInvalidMemo, "invalid memo")
+	EmptyAccountErr      = codes.Register(CodeEmptyAccount, "empty account")
 )
 
 func ErrInsufficientFees(coin x.Coin) error {
-	msg := coin.String()
-	return errors.WithLog(msg, errInsufficientFees, CodeInsufficientFees)
+	return InsufficientFeesErr.New(coin.String())
 }
 func IsInsufficientFeesErr(err error) bool {
-	return errors.IsSameError(errInsufficientFees, err)
+	return errors.HasErrorCode(err, CodeInsufficientFees)
 }
 
 func ErrInsufficientFunds() error {
-	return errors.WithCode(errInsufficientFunds, CodeInsufficientFunds)
+	return InsufficientFundsErr
 }
 func IsInsufficientFundsErr(err error) bool {
-	return errors.IsSameError(errInsufficientFunds, err)
+	return errors.HasErrorCode(err, CodeInsufficientFunds)
 }
 
 func ErrInvalidAmount(reason string) error {
-	return errors.WithLog(reason, errInvalidAmount, CodeInvalidAmount)
+	return InvalidAmountErr.New(reason)
 }
 func IsInvalidAmountErr(err error) bool {
-	return errors.IsSameError(errInvalidAmount, err)
+	return errors.HasErrorCode(err, CodeInvalidAmount)
 }
 
 func ErrInvalidMemo(reason string) error {
-	return errors.WithLog(reason, errInvalidMemo, CodeInvalidMemo)
+	return InvalidMemoErr.New(reason)
 }
 func IsInvalidMemoErr(err error) bool {
-	return errors.IsSameError(errInvalidMemo, err)
+	return errors.HasErrorCode(err, CodeInvalidMemo)
 }
 
 func ErrEmptyAccount(addr weave.Address) error {
-	return errors.WithLog(addr.String(), errEmptyAccount, CodeEmptyAccount)
+	return EmptyAccountErr.New(addr.String())
 }
 func IsEmptyAccountErr(err error) bool {
-	return errors.IsSameError(errEmptyAccount, err)
+	return errors.HasErrorCode(err, CodeEmptyAccount)
 }
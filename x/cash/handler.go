@@ -93,7 +93,11 @@ func (h SendHandler) Deliver(ctx weave.Context, store weave.KVStore,
 	}
 
 	// move the money....
-	err = h.control.MoveCoins(store, msg.Src, msg.Dest, *msg.Amount)
+	if msg.PreciseAmount != nil {
+		err = MoveAmount(h.control, store, msg.Src, msg.Dest, *msg.PreciseAmount)
+	} else {
+		err = h.control.MoveCoins(store, msg.Src, msg.Dest, *msg.Amount)
+	}
 	if err != nil {
 		return res, err
 	}
@@ -2,26 +2,39 @@ package cash
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x"
 )
 
 const optKey = "cash"
 
 // GenesisAccount is used to parse the json from genesis file
-// use weave.Address, so address in hex, not base64
+// use weave.Address, so address in hex, not base64.
+// Coins can be given as objects or as strings, eg. "12.5 IOV"
+// (see x.ParseCoin)
 type GenesisAccount struct {
 	Address weave.Address `json:"address"`
 	Set
 }
 
+// CoinValidator checks if a coin is supported, eg. by a token registry
+// like currency.TokenInfoBucket
+type CoinValidator interface {
+	ValidateCoin(db weave.KVStore, c x.Coin) error
+}
+
 // Initializer fulfils the InitStater interface to load data from
 // the genesis file
-type Initializer struct{}
+type Initializer struct {
+	// Tokens, if set, validates all genesis coins. The token registry
+	// must be loaded from the genesis before.
+	Tokens CoinValidator
+}
 
 var _ weave.Initializer = Initializer{}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
-func (Initializer) FromGenesis(opts weave.Options, kv weave.KVStore) error {
+func (i Initializer) FromGenesis(opts weave.Options, kv weave.KVStore) error {
 	accts := []GenesisAccount{}
 	err := opts.ReadOptions(optKey, &accts)
 	if err != nil {
@@ -32,6 +45,13 @@ func (Initializer) FromGenesis(opts weave.Options, kv weave.KVStore) error {
 		if err := acct.Address.Validate(); err != nil {
 			return err
 		}
+		if i.Tokens != nil {
+			for _, c := range acct.Set.Coins {
+				if err := i.Tokens.ValidateCoin(kv, *c); err != nil {
+					return err
+				}
+			}
+		}
 		wallet, err := WalletWith(acct.Address, acct.Set.Coins...)
 		if err != nil {
 			return err
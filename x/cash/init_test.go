@@ -15,7 +15,7 @@ import (
 func TestInitState(t *testing.T) {
 	// test data
 	addr := []byte("12345678901234567890")
-	coins := Set{mustCombineCoins(x.NewCoin(100, 5, "ATM"), x.NewCoin(50, 0, "ETH").WithIssuer("chain-1"))}
+	coins := Set{Coins: mustCombineCoins(x.NewCoin(100, 5, "ATM"), x.NewCoin(50, 0, "ETH").WithIssuer("chain-1"))}
 	accts := []GenesisAccount{{Address: addr, Set: coins}}
 
 	bz, err := json.Marshal(accts)
@@ -27,7 +27,7 @@ func TestInitState(t *testing.T) {
                 "fractional":1234567,
                 "ticker":"FOO"
               }]}]`)
-	coins2 := Set{mustCombineCoins(x.NewCoin(50, 1234567, "FOO"))}
+	coins2 := Set{Coins: mustCombineCoins(x.NewCoin(50, 1234567, "FOO"))}
 	addr2 := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x30}
 
 	cases := [...]struct {
@@ -46,6 +46,11 @@ func TestInitState(t *testing.T) {
 		// get a real account
 		4: {weave.Options{"cash": bz}, false, addr, coins},
 		5: {weave.Options{"cash": bz2}, false, addr2, coins2},
+		// human readable coins
+		6: {weave.Options{"cash": []byte(`[{"address":"0102030405060708090021222324252627282930",
+                "coins":["50.001234567 FOO"]}]`)}, false, addr2, coins2},
+		7: {weave.Options{"cash": []byte(`[{"address":"0102030405060708090021222324252627282930",
+                "coins":["50.001234567"]}]`)}, true, nil, Set{}},
 	}
 
 	init := Initializer{}
@@ -80,3 +85,37 @@ func mustCombineCoins(cs ...x.Coin) x.Coins {
 	}
 	return s
 }
+
+// sigFigs validates coins against the significant figures of
+// their token, like a token registry
+type sigFigs map[string]int32
+
+func (s sigFigs) ValidateCoin(db weave.KVStore, c x.Coin) error {
+	figs, ok := s[c.Ticker]
+	if !ok || !c.WithinSigFigs(figs) {
+		return x.ErrInvalidCurrency(c.Ticker)
+	}
+	return nil
+}
+
+func TestInitStateTokens(t *testing.T) {
+	init := Initializer{Tokens: sigFigs{"FOO": 3}}
+	cases := map[string]struct {
+		coins   string
+		wantErr bool
+	}{
+		"within significant figures": {coins: `["50.125 FOO"]`},
+		"too precise":                {coins: `["50.0001 FOO"]`, wantErr: true},
+		"unknown token":              {coins: `["50 FOO", "1 BAR"]`, wantErr: true},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			opts := weave.Options{"cash": []byte(`[{"address":"0102030405060708090021222324252627282930",
+                "coins":` + tc.coins + `}]`)}
+			err := init.FromGenesis(opts, store.MemStore())
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -13,17 +13,31 @@ const BucketName = "cash"
 //---- Set
 
 var _ orm.CloneableData = (*Set)(nil)
-var _ Coinage = (*Set)(nil)
+var _ PreciseCoinage = (*Set)(nil)
 
-// Validate requires that all coins are in alphabetical
+// Validate requires that all coins and amounts are in alphabetical
+// order, and that no token is held in both
 func (s *Set) Validate() error {
-	return XCoins(s).Validate()
+	if err := XCoins(s).Validate(); err != nil {
+		return err
+	}
+	amounts := x.Amounts(s.Amounts)
+	if err := amounts.Validate(); err != nil {
+		return err
+	}
+	for _, c := range s.Coins {
+		if amounts.Get(c.ID()) != nil {
+			return x.ErrInvalidWallet("Token held as coin and amount")
+		}
+	}
+	return nil
 }
 
 // Copy makes a new set with the same coins
 func (s *Set) Copy() orm.CloneableData {
 	return &Set{
-		Coins: XCoins(s).Clone(),
+		Coins:   XCoins(s).Clone(),
+		Amounts: x.Amounts(s.Amounts).Clone(),
 	}
 }
 
@@ -32,6 +46,11 @@ func (s *Set) SetCoins(coins []*x.Coin) {
 	s.Coins = coins
 }
 
+// SetAmounts allows us to modify the Set
+func (s *Set) SetAmounts(amounts []*x.Amount) {
+	s.Amounts = amounts
+}
+
 //------ generic Coinage functionality
 
 // Coinage is any model that allows getting and setting coins,
@@ -43,6 +62,15 @@ type Coinage interface {
 	SetCoins([]*x.Coin)
 }
 
+// PreciseCoinage is a Coinage that can also hold balances that do
+// not fit in a Coin. Only those are stored as amounts, everything
+// else is kept in coins.
+type PreciseCoinage interface {
+	Coinage
+	GetAmounts() []*x.Amount
+	SetAmounts([]*x.Amount)
+}
+
 // XCoins returns the stored coins cast properly
 func XCoins(c Coinage) x.Coins {
 	if c == nil {
@@ -65,8 +93,54 @@ func AsCoins(obj orm.Object) x.Coins {
 	return XCoins(c)
 }
 
-// Add modifies the coinage to add Coin c
+// Balance returns all holdings of the coinage as amounts,
+// whether they are stored as coins or amounts
+func Balance(cng Coinage) x.Amounts {
+	if cng == nil {
+		return nil
+	}
+	balance := XCoins(cng).Amounts()
+	if p, ok := cng.(PreciseCoinage); ok {
+		// a token is never held in both, so this cannot fail
+		balance, _ = balance.Combine(p.GetAmounts())
+	}
+	return balance
+}
+
+// AddAmount modifies the coinage to add Amount a.
+// The balance is stored as a Coin if possible, otherwise
+// the coinage must be a PreciseCoinage.
+func AddAmount(cng Coinage, a x.Amount) error {
+	balance, err := Balance(cng).Add(a)
+	if err != nil {
+		return err
+	}
+	coins, rest := balance.Split()
+	p, ok := cng.(PreciseCoinage)
+	if !ok {
+		if len(rest) > 0 {
+			return x.ErrAmountOutOfRange(*rest[0])
+		}
+		cng.SetCoins(coins)
+		return nil
+	}
+	cng.SetCoins(coins)
+	p.SetAmounts(rest)
+	return nil
+}
+
+// SubtractAmount modifies the coinage to remove Amount a
+func SubtractAmount(cng Coinage, a x.Amount) error {
+	return AddAmount(cng, a.Negative())
+}
+
+// Add modifies the coinage to add Coin c.
+// A PreciseCoinage keeps a balance that exceeds the Coin range
+// as an amount, see AddAmount.
 func Add(cng Coinage, c x.Coin) error {
+	if _, ok := cng.(PreciseCoinage); ok {
+		return AddAmount(cng, c.Amount())
+	}
 	cs, err := XCoins(cng).Add(c)
 	if err != nil {
 		return err
@@ -24,12 +24,24 @@ func (SendMsg) Path() string {
 
 // Validate makes sure that this is sensible
 func (s *SendMsg) Validate() error {
-	amt := s.GetAmount()
-	if x.IsEmpty(amt) || !amt.IsPositive() {
-		return ErrInvalidAmount("Non-positive SendMsg")
+	if s.Amount != nil && s.PreciseAmount != nil {
+		return ErrInvalidAmount("Both amount and precise amount set")
 	}
-	if err := amt.Validate(); err != nil {
-		return err
+	if s.PreciseAmount != nil {
+		if !s.PreciseAmount.IsPositive() {
+			return ErrInvalidAmount("Non-positive SendMsg")
+		}
+		if err := s.PreciseAmount.Validate(); err != nil {
+			return err
+		}
+	} else {
+		amt := s.GetAmount()
+		if x.IsEmpty(amt) || !amt.IsPositive() {
+			return ErrInvalidAmount("Non-positive SendMsg")
+		}
+		if err := amt.Validate(); err != nil {
+			return err
+		}
 	}
 	if err := weave.Address(s.Src).Validate(); err != nil {
 		return err
@@ -59,6 +71,8 @@ func (s *SendMsg) DefaultSource(addr []byte) *SendMsg {
 		Amount: s.GetAmount(),
 		Memo:   s.GetMemo(),
 		Ref:    s.GetRef(),
+
+		PreciseAmount: s.GetPreciseAmount(),
 	}
 }
 
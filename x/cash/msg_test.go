@@ -81,6 +81,24 @@ func TestValidateSendMsg(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, x.IsInvalidCurrencyErr(err))
 
+	precise := x.Amount{Value: "1000000000000000001", Decimals: 18, Ticker: "ETH"}
+	fine := &SendMsg{
+		PreciseAmount: &precise,
+		Dest:          addr2,
+		Src:           addr3,
+	}
+	assert.NoError(t, fine.Validate())
+
+	// only one amount may be set
+	fine.Amount = &pos
+	err = fine.Validate()
+	assert.True(t, IsInvalidAmountErr(err))
+
+	negPrecise := precise.Negative()
+	fine.Amount = nil
+	fine.PreciseAmount = &negPrecise
+	err = fine.Validate()
+	assert.True(t, IsInvalidAmountErr(err))
 }
 
 func TestValidateFeeTx(t *testing.T) {
@@ -9,12 +9,14 @@
 
 	It has these top-level messages:
 		Coin
+		Amount
 */
 package x
 
 import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
 
 import io "io"
 
@@ -54,7 +56,6 @@ type Coin struct {
 }
 
 func (m *Coin) Reset()                    { *m = Coin{} }
-func (m *Coin) String() string            { return proto.CompactTextString(m) }
 func (*Coin) ProtoMessage()               {}
 func (*Coin) Descriptor() ([]byte, []int) { return fileDescriptorCodec, []int{0} }
 
@@ -86,8 +87,62 @@ func (m *Coin) GetIssuer() string {
 	return ""
 }
 
+// Amount is an arbitrary precision value of a token. It is used where
+// Coin is too limited, eg. for tokens with 18 decimals bridged from
+// other chains or for supplies above 10^15 whole units.
+//
+// Every amount declares the decimals of its token. Amounts of the same
+// token with different decimals can be combined, the result uses the
+// larger number of decimals.
+type Amount struct {
+	// Value counts the smallest units of the token, 10^-decimals of a whole
+	// token, eg. "1500000000000000000" is 1.5 of a token with 18 decimals.
+	// It is a decimal integer without leading zeros, that may start with "-".
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Decimals is the number of decimal places of the token, 0 <= decimals <= 36
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Ticker is 3-4 upper-case letters, like for Coin
+	Ticker string `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Issuer is optional, like for Coin
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (m *Amount) Reset()                    { *m = Amount{} }
+func (m *Amount) String() string            { return proto.CompactTextString(m) }
+func (*Amount) ProtoMessage()               {}
+func (*Amount) Descriptor() ([]byte, []int) { return fileDescriptorCodec, []int{1} }
+
+func (m *Amount) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Amount) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *Amount) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *Amount) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Coin)(nil), "x.Coin")
+	proto.RegisterType((*Amount)(nil), "x.Amount")
 }
 func (m *Coin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -129,6 +184,47 @@ func (m *Coin) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Amount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Decimals != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Decimals))
+	}
+	if len(m.Ticker) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ticker)))
+		i += copy(dAtA[i:], m.Ticker)
+	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -158,6 +254,27 @@ func (m *Coin) Size() (n int) {
 	return n
 }
 
+func (m *Amount) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovCodec(uint64(m.Decimals))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -317,6 +434,162 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Amount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -425,14 +698,19 @@ var (
 func init() { proto.RegisterFile("x/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 144 bytes of a gzipped FileDescriptorProto
+	// 220 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xad, 0xd0, 0x4f, 0xce,
-	0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xac, 0x50, 0xca, 0xe1, 0x62,
-	0x71, 0xce, 0xcf, 0xcc, 0x13, 0x12, 0xe1, 0x62, 0x2d, 0xcf, 0xc8, 0xcf, 0x49, 0x95, 0x60, 0x54,
-	0x60, 0xd4, 0x60, 0x0e, 0x82, 0x70, 0x84, 0xe4, 0xb8, 0xb8, 0xd2, 0x8a, 0x12, 0x93, 0x4b, 0x32,
-	0xf3, 0xf3, 0x12, 0x73, 0x24, 0x98, 0xc0, 0x52, 0x48, 0x22, 0x42, 0x62, 0x5c, 0x6c, 0x25, 0x99,
-	0xc9, 0xd9, 0xa9, 0x45, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x1e, 0x48, 0x3c, 0xb3,
-	0xb8, 0xb8, 0x34, 0xb5, 0x48, 0x82, 0x05, 0x22, 0x0e, 0xe1, 0x39, 0x09, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81,
-	0x5d, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x13, 0x15, 0x6a, 0x13, 0x9a, 0x00, 0x00, 0x00,
+	0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xac, 0x90, 0xd2, 0x4d, 0xcf,
+	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xcb,
+	0x24, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xa1, 0x54, 0xc4, 0xc5, 0xe2, 0x9c,
+	0x9f, 0x99, 0x27, 0x24, 0xc2, 0xc5, 0x5a, 0x9e, 0x91, 0x9f, 0x93, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8,
+	0xc1, 0x1c, 0x04, 0xe1, 0x08, 0xc9, 0x71, 0x71, 0xa5, 0x15, 0x25, 0x26, 0x97, 0x64, 0xe6, 0xe7,
+	0x25, 0xe6, 0x48, 0x30, 0x81, 0xa5, 0x90, 0x44, 0x84, 0xc4, 0xb8, 0xd8, 0x4a, 0x32, 0x93, 0xb3,
+	0x53, 0x8b, 0x24, 0x98, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x90, 0x78, 0x66, 0x71, 0x71,
+	0x69, 0x6a, 0x91, 0x04, 0x0b, 0x44, 0x1c, 0xc2, 0xb3, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0x41, 0x29,
+	0x8b, 0x8b, 0xcd, 0x31, 0x37, 0xbf, 0x34, 0xaf, 0x04, 0x64, 0x6b, 0x59, 0x62, 0x4e, 0x29, 0xc4,
+	0x56, 0xce, 0x20, 0x08, 0x47, 0x48, 0x8a, 0x8b, 0x23, 0x25, 0x35, 0x39, 0x33, 0x37, 0x31, 0xa7,
+	0x18, 0x6c, 0x27, 0x6f, 0x10, 0x9c, 0x4f, 0xaa, 0x8d, 0x4e, 0x02, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0x60, 0x8f,
+	0x1b, 0x03, 0x06, 0x00, 0xa0, 0xd5, 0x7d, 0x59, 0x3b, 0x01, 0x00, 0x00,
 }
@@ -1,7 +1,12 @@
 package x
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 //-------------- Coin -----------------------
@@ -51,6 +56,107 @@ func (c Coin) ID() string {
 	return c.Issuer + "/" + c.Ticker
 }
 
+// String formats the coin for humans, eg. "12.5 IOV" or
+// "-0.000001 chain-1/ETH". ParseCoin reads this format.
+// A coin with mixed signs or a fractional part out of range
+// is formatted literally, eg. "1 whole -5 fractional IOV".
+func (c Coin) String() string {
+	value := c.formatValue()
+	if c.ID() == "" {
+		return value
+	}
+	return value + " " + c.ID()
+}
+
+// formatValue formats the value of the coin, see String
+func (c Coin) formatValue() string {
+	whole, frac := c.Whole, c.Fractional
+	if (whole > 0 && frac < 0) || (whole < 0 && frac > 0) ||
+		frac >= FracUnit || frac <= -FracUnit {
+		return fmt.Sprintf("%d whole %d fractional", whole, frac)
+	}
+	sign := ""
+	if whole < 0 || frac < 0 {
+		sign = "-"
+		whole, frac = -whole, -frac
+	}
+	value := sign + strconv.FormatInt(whole, 10)
+	if frac != 0 {
+		digits := fmt.Sprintf("%09d", frac)
+		value += "." + strings.TrimRight(digits, "0")
+	}
+	return value
+}
+
+// coinFormat matches the output of Coin.String,
+// with optional space between value and ticker
+var coinFormat = regexp.MustCompile(`^(-?)(\d+)(?:\.(\d+))?\s*(?:([^\s/]+)/)?([A-Z]{3,4})$`)
+
+// ParseCoin parses a human readable coin, as produced by
+// Coin.String, eg. "12.5 IOV" or "1 chain-1/ETH".
+// The value may not have more than 9 decimals.
+func ParseCoin(s string) (Coin, error) {
+	m := coinFormat.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Coin{}, ErrInvalidCoinFormat(s)
+	}
+	whole, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil || whole > MaxInt {
+		return Coin{}, ErrInvalidCoinFormat(s)
+	}
+	var frac int64
+	if m[3] != "" {
+		if len(m[3]) > 9 {
+			return Coin{}, ErrInvalidCoinFormat(s)
+		}
+		// pad to billionths, this cannot fail with 9 digits
+		frac, _ = strconv.ParseInt(m[3]+strings.Repeat("0", 9-len(m[3])), 10, 64)
+	}
+	if m[1] == "-" {
+		whole, frac = -whole, -frac
+	}
+	c := NewCoin(whole, frac, m[5]).WithIssuer(m[4])
+	return c, c.Validate()
+}
+
+// UnmarshalJSON accepts a coin either as a JSON object or as a
+// string in the format of ParseCoin, eg. "12.5 IOV". This allows
+// human readable coins in genesis files.
+func (c *Coin) UnmarshalJSON(raw []byte) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		coin, err := ParseCoin(s)
+		if err != nil {
+			return err
+		}
+		*c = coin
+		return nil
+	}
+	// the alias has no methods, so this does not recurse
+	type coinAlias Coin
+	return json.Unmarshal(raw, (*coinAlias)(c))
+}
+
+// WithinSigFigs returns true if the coin has no more than
+// sigFigs decimal places, as defined by currency.TokenInfo
+func (c Coin) WithinSigFigs(sigFigs int32) bool {
+	if sigFigs < 0 {
+		return false
+	}
+	if sigFigs >= 9 {
+		return true
+	}
+	unit := int64(1)
+	for i := sigFigs; i < 9; i++ {
+		unit *= 10
+	}
+	return c.Fractional%unit == 0
+}
+
 // Add combines two coins.
 // Returns error if they are of different
 // currencies, or if the combination would cause
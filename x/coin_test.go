@@ -1,10 +1,12 @@
 package x
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type cmp int
@@ -324,3 +326,86 @@ func TestCoinSubtract(t *testing.T) {
 		})
 	}
 }
+
+func TestCoinString(t *testing.T) {
+	cases := map[string]struct {
+		coin Coin
+		want string
+	}{
+		"whole":       {NewCoin(12, 0, "IOV"), "12 IOV"},
+		"fractional":  {NewCoin(12, 500000000, "IOV"), "12.5 IOV"},
+		"tiny":        {NewCoin(0, 1, "IOV"), "0.000000001 IOV"},
+		"negative":    {NewCoin(-1, -20000000, "ETH"), "-1.02 ETH"},
+		"issuer":      {NewCoin(7, 0, "ETH").WithIssuer("chain-1"), "7 chain-1/ETH"},
+		"empty":       {Coin{}, "0"},
+		"mixed signs": {Coin{Whole: 1, Fractional: -5, Ticker: "IOV"}, "1 whole -5 fractional IOV"},
+		"overflow":    {Coin{Fractional: FracUnit, Ticker: "IOV"}, "0 whole 1000000000 fractional IOV"},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.coin.String())
+			if tc.coin.Ticker == "" || tc.coin.Validate() != nil {
+				return
+			}
+			// the format can be parsed back
+			parsed, err := ParseCoin(tc.want)
+			require.NoError(t, err)
+			assert.Equal(t, tc.coin, parsed)
+		})
+	}
+}
+
+func TestParseCoin(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want Coin
+		bad  bool
+	}{
+		"no space":         {in: "3.25IOV", want: NewCoin(3, 250000000, "IOV")},
+		"spaces":           {in: "  3 IOV ", want: NewCoin(3, 0, "IOV")},
+		"leading zeros":    {in: "003.0100 IOV", want: NewCoin(3, 10000000, "IOV")},
+		"max":              {in: "999999999999999.999999999 IOV", want: NewCoin(MaxInt, MaxFrac, "IOV")},
+		"too large":        {in: "1000000000000000 IOV", bad: true},
+		"too precise":      {in: "0.0000000001 IOV", bad: true},
+		"no ticker":        {in: "12", bad: true},
+		"no value":         {in: "IOV", bad: true},
+		"lowercase ticker": {in: "1 iov", bad: true},
+		"plus sign":        {in: "+1 IOV", bad: true},
+		"empty fraction":   {in: "1. IOV", bad: true},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			c, err := ParseCoin(tc.in)
+			if tc.bad {
+				assert.True(t, IsInvalidCoinErr(err), "%+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, c)
+		})
+	}
+}
+
+func TestCoinJSON(t *testing.T) {
+	var cs Coins
+	err := json.Unmarshal([]byte(`["1.5 IOV", {"whole": 2, "ticker": "ETH"}]`), &cs)
+	require.NoError(t, err)
+	assert.Equal(t, Coins{
+		&Coin{Whole: 1, Fractional: 500000000, Ticker: "IOV"},
+		&Coin{Whole: 2, Ticker: "ETH"},
+	}, cs)
+
+	err = json.Unmarshal([]byte(`"1.5"`), new(Coin))
+	assert.Error(t, err)
+}
+
+func TestCoinWithinSigFigs(t *testing.T) {
+	c := NewCoin(1, 230000000, "IOV")
+	assert.False(t, c.WithinSigFigs(0))
+	assert.False(t, c.WithinSigFigs(1))
+	assert.True(t, c.WithinSigFigs(2))
+	assert.True(t, c.WithinSigFigs(9))
+	assert.True(t, NewCoin(5, 0, "IOV").WithinSigFigs(0))
+}
@@ -29,6 +29,21 @@ func CombineCoins(cs ...Coin) (Coins, error) {
 	return coins, nil
 }
 
+// ParseCoins parses a comma separated list of coins in the
+// format of ParseCoin, eg. "1 IOV, 2.5 ETH". Coins of the same
+// currency are added up.
+func ParseCoins(s string) (Coins, error) {
+	var cs []Coin
+	for _, part := range strings.Split(s, ",") {
+		c, err := ParseCoin(part)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return CombineCoins(cs...)
+}
+
 // Clone returns a copy that can be safely modified
 func (cs Coins) Clone() Coins {
 	if cs == nil {
@@ -167,8 +182,8 @@ func (cs Coins) Count() int {
 	return len(cs)
 }
 
-// Validate requires that all coins are in alphabetical
-// order and that each coin is valid in it's own right
+// Validate requires that all coins are in alphabetical order of their ID,
+// as kept by Add, and that each coin is valid in it's own right
 //
 // Zero amounts should not be present
 func (cs Coins) Validate() error {
@@ -180,10 +195,10 @@ func (cs Coins) Validate() error {
 		if c.IsZero() {
 			return ErrInvalidWallet("Zero coins")
 		}
-		if c.Ticker < last {
+		if c.ID() < last {
 			return ErrInvalidWallet("Not sorted")
 		}
-		last = c.Ticker
+		last = c.ID()
 	}
 	return nil
 }
@@ -180,6 +180,13 @@ func TestCombine(t *testing.T) {
 			mustCombineCoins(NewCoin(5, 4, "APE"), NewCoin(8, 9, "BAR"), NewCoin(9, 9, "FOO")),
 			false,
 		},
+		// tokens of other chains
+		{
+			mustCombineCoins(NewCoin(1, 0, "IOV")),
+			mustCombineCoins(NewCoin(2, 0, "ETH").WithIssuer("chain-1")),
+			mustCombineCoins(NewCoin(1, 0, "IOV"), NewCoin(2, 0, "ETH").WithIssuer("chain-1")),
+			false,
+		},
 		// overflows
 		{
 			mustCombineCoins(NewCoin(MaxInt, 0, "ADA")),
@@ -215,3 +222,15 @@ func TestCombine(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCoins(t *testing.T) {
+	cs, err := ParseCoins("1 IOV, 2.5 ETH,0.5 IOV")
+	require.NoError(t, err)
+	assert.Equal(t, Coins{
+		&Coin{Whole: 2, Fractional: 500000000, Ticker: "ETH"},
+		&Coin{Whole: 1, Fractional: 500000000, Ticker: "IOV"},
+	}, cs)
+
+	_, err = ParseCoins("1 IOV,")
+	assert.True(t, IsInvalidCoinErr(err))
+}
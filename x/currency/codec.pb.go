@@ -33,8 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // TokenInfo contains information about a single currency. It is used as an
 // alternative solution to hardcoding supported currencies information.
 type TokenInfo struct {
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	SigFigs int32  `protobuf:"varint,2,opt,name=sig_figs,json=sigFigs,proto3" json:"sig_figs,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// SigFigs is the number of decimal places of the token. Every Amount
+	// of the token uses that many decimals.
+	SigFigs int32 `protobuf:"varint,2,opt,name=sig_figs,json=sigFigs,proto3" json:"sig_figs,omitempty"`
 }
 
 func (m *TokenInfo) Reset()                    { *m = TokenInfo{} }
@@ -62,6 +64,9 @@ type NewTokenInfoMsg struct {
 	Ticker  string `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
 	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	SigFigs int32  `protobuf:"varint,3,opt,name=sig_figs,json=sigFigs,proto3" json:"sig_figs,omitempty"`
+	// Issuer is the chain issuing the token, empty for tokens of this chain.
+	// A token is registered once per issuer.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *NewTokenInfoMsg) Reset()                    { *m = NewTokenInfoMsg{} }
@@ -90,6 +95,13 @@ func (m *NewTokenInfoMsg) GetSigFigs() int32 {
 	return 0
 }
 
+func (m *NewTokenInfoMsg) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TokenInfo)(nil), "currency.TokenInfo")
 	proto.RegisterType((*NewTokenInfoMsg)(nil), "currency.NewTokenInfoMsg")
@@ -155,6 +167,12 @@ func (m *NewTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SigFigs))
 	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
 	return i, nil
 }
 
@@ -194,6 +212,10 @@ func (m *NewTokenInfoMsg) Size() (n int) {
 	if m.SigFigs != 0 {
 		n += 1 + sovCodec(uint64(m.SigFigs))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -414,6 +436,35 @@ func (m *NewTokenInfoMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -543,16 +594,17 @@ var (
 func init() { proto.RegisterFile("x/currency/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 168 bytes of a gzipped FileDescriptorProto
+	// 178 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xab, 0xd0, 0x4f, 0x2e,
 	0x2d, 0x2a, 0x4a, 0xcd, 0x4b, 0xae, 0xd4, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0xe2, 0x80, 0x89, 0x2a, 0x59, 0x71, 0x71, 0x86, 0xe4, 0x67, 0xa7, 0xe6, 0x79,
 	0xe6, 0xa5, 0xe5, 0x0b, 0x09, 0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
 	0x70, 0x06, 0x81, 0xd9, 0x42, 0x92, 0x5c, 0x1c, 0xc5, 0x99, 0xe9, 0xf1, 0x69, 0x99, 0xe9, 0xc5,
 	0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0xec, 0xc5, 0x99, 0xe9, 0x6e, 0x99, 0xe9, 0xc5, 0x4a,
-	0x11, 0x5c, 0xfc, 0x7e, 0xa9, 0xe5, 0x70, 0xed, 0xbe, 0xc5, 0xe9, 0x42, 0x62, 0x5c, 0x6c, 0x25,
+	0x05, 0x5c, 0xfc, 0x7e, 0xa9, 0xe5, 0x70, 0xed, 0xbe, 0xc5, 0xe9, 0x42, 0x62, 0x5c, 0x6c, 0x25,
 	0x99, 0xc9, 0xd9, 0xa9, 0x45, 0x50, 0x33, 0xa0, 0x3c, 0xb8, 0xc9, 0x4c, 0x38, 0x4c, 0x66, 0x46,
-	0x31, 0xd9, 0x49, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0xec, 0x70, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc8, 0x2f, 0x73, 0xe8, 0xd2, 0x00, 0x00, 0x00,
+	0x31, 0x19, 0x64, 0x4c, 0x66, 0x71, 0x71, 0x69, 0x6a, 0x91, 0x04, 0x0b, 0xc4, 0x18, 0x08, 0xcf,
+	0x49, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
+	0x58, 0x8e, 0x21, 0x89, 0x0d, 0xec, 0x21, 0x63, 0xc0, 0x00, 0x39, 0xfa, 0xc4, 0x3f, 0xea, 0x00,
+	0x00, 0x00,
 }
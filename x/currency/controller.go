@@ -0,0 +1,70 @@
+package currency
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+)
+
+// Controller wraps a cash.Controller and refuses to move or issue tokens
+// that are not registered in the TokenInfoBucket, values that are more
+// precise than the significant figures of their token, or amounts that
+// do not use the decimals of their token.
+//
+// Use it wherever a cash.Controller is expected (cash, escrow, paychan,
+// the fee decorator) to enforce the token registry everywhere.
+type Controller struct {
+	cash.Controller
+	bucket *TokenInfoBucket
+}
+
+var _ cash.AmountController = Controller{}
+
+// NewController returns a controller validating all values against the
+// token registry before passing them to ctrl.
+func NewController(ctrl cash.Controller) Controller {
+	return Controller{
+		Controller: ctrl,
+		bucket:     NewTokenInfoBucket(),
+	}
+}
+
+// MoveCoins validates the coin before moving it
+func (c Controller) MoveCoins(store weave.KVStore,
+	src weave.Address, dest weave.Address, amount x.Coin) error {
+
+	if err := c.bucket.ValidateCoin(store, amount); err != nil {
+		return err
+	}
+	return c.Controller.MoveCoins(store, src, dest, amount)
+}
+
+// IssueCoins validates the coin before issuing it
+func (c Controller) IssueCoins(store weave.KVStore,
+	dest weave.Address, amount x.Coin) error {
+
+	if err := c.bucket.ValidateCoin(store, amount); err != nil {
+		return err
+	}
+	return c.Controller.IssueCoins(store, dest, amount)
+}
+
+// MoveAmount validates the amount before moving it
+func (c Controller) MoveAmount(store weave.KVStore,
+	src weave.Address, dest weave.Address, amount x.Amount) error {
+
+	if err := c.bucket.ValidateAmount(store, amount); err != nil {
+		return err
+	}
+	return cash.MoveAmount(c.Controller, store, src, dest, amount)
+}
+
+// IssueAmount validates the amount before issuing it
+func (c Controller) IssueAmount(store weave.KVStore,
+	dest weave.Address, amount x.Amount) error {
+
+	if err := c.bucket.ValidateAmount(store, amount); err != nil {
+		return err
+	}
+	return cash.IssueAmount(c.Controller, store, dest, amount)
+}
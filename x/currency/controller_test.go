@@ -0,0 +1,91 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestController(t *testing.T) {
+	var helpers x.TestHelpers
+	_, src := helpers.MakeKey()
+	_, dest := helpers.MakeKey()
+
+	db := store.MemStore()
+	if err := NewTokenInfoBucket().Save(db, NewTokenInfo("DOGE", "Doge Coin", 2)); err != nil {
+		t.Fatalf("cannot register doge: %s", err)
+	}
+	if err := NewTokenInfoBucket().Save(db, NewTokenInfo("chain-1/ETH", "Ether", 18)); err != nil {
+		t.Fatalf("cannot register ether: %s", err)
+	}
+	ctrl := NewController(cash.NewController(cash.NewBucket()))
+	if err := ctrl.IssueCoins(db, src.Address(), x.NewCoin(100, 0, "DOGE")); err != nil {
+		t.Fatalf("cannot issue doge: %s", err)
+	}
+	if err := ctrl.IssueCoins(db, src.Address(), x.NewCoin(100, 0, "ETH").WithIssuer("chain-1")); err != nil {
+		t.Fatalf("cannot issue ether: %s", err)
+	}
+
+	coins := map[string]struct {
+		coin    x.Coin
+		wantErr bool
+	}{
+		"within significant figures": {coin: x.NewCoin(1, 250000000, "DOGE")},
+		"too precise":                {coin: x.NewCoin(0, 1, "DOGE"), wantErr: true},
+		"unknown token":              {coin: x.NewCoin(1, 0, "XYZ"), wantErr: true},
+		"token of another chain":     {coin: x.NewCoin(1, 5, "ETH").WithIssuer("chain-1")},
+		"unknown issuer":             {coin: x.NewCoin(1, 0, "ETH").WithIssuer("chain-2"), wantErr: true},
+		"unregistered issuer token":  {coin: x.NewCoin(1, 0, "DOGE").WithIssuer("chain-1"), wantErr: true},
+	}
+	for testName, tc := range coins {
+		t.Run("move coins "+testName, func(t *testing.T) {
+			err := ctrl.MoveCoins(db, src.Address(), dest.Address(), tc.coin)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr && !errors.HasErrorCode(err, CodeInvalidToken) {
+				t.Fatalf("want invalid token error, got %v", err)
+			}
+		})
+		t.Run("issue coins "+testName, func(t *testing.T) {
+			err := ctrl.IssueCoins(db, dest.Address(), tc.coin)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	amounts := map[string]struct {
+		amount  x.Amount
+		wantErr bool
+	}{
+		"within significant figures": {amount: x.Amount{Value: "150", Decimals: 2, Ticker: "DOGE"}},
+		"coin decimals":              {amount: x.Amount{Value: "1500000000", Decimals: 9, Ticker: "DOGE"}},
+		"other decimals":             {amount: x.Amount{Value: "1500000000000000000", Decimals: 18, Ticker: "DOGE"}, wantErr: true},
+		"no decimals":                {amount: x.Amount{Value: "1", Ticker: "DOGE"}, wantErr: true},
+		"too precise":                {amount: x.Amount{Value: "15", Decimals: 3, Ticker: "DOGE"}, wantErr: true},
+		"unknown token":              {amount: x.Amount{Value: "1", Ticker: "XYZ"}, wantErr: true},
+		"token of another chain":     {amount: x.Amount{Value: "1", Decimals: 18, Ticker: "ETH", Issuer: "chain-1"}},
+		"unregistered issuer token":  {amount: x.Amount{Value: "1", Decimals: 2, Ticker: "DOGE", Issuer: "chain-1"}, wantErr: true},
+	}
+	for testName, tc := range amounts {
+		t.Run("move amount "+testName, func(t *testing.T) {
+			err := ctrl.MoveAmount(db, src.Address(), dest.Address(), tc.amount)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr && !errors.HasErrorCode(err, CodeInvalidToken) {
+				t.Fatalf("want invalid token error, got %v", err)
+			}
+		})
+		t.Run("issue amount "+testName, func(t *testing.T) {
+			err := ctrl.IssueAmount(db, dest.Address(), tc.amount)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -3,5 +3,14 @@ Package currency provides an implementation of a token registry. It allows to
 keep keep track of token/currency configuration.
 
 Once configured, token declaration cannot be altered.
+
+The significant figures of a token are also its decimals: every x.Amount
+of the token must use them, so that a value cannot be expressed with
+another precision. Amounts converted from a coin use x.CoinDecimals.
+
+Controller wraps a cash.Controller so that only registered tokens can be
+moved, and only with as many decimal places as their significant figures
+allow. Pass it to cash, escrow, paychan and the fee decorator to enforce
+the registry for all transfers.
 */
 package currency
@@ -1,31 +1,43 @@
 package currency
 
 import (
-	stderrors "errors"
-	fmt "fmt"
+	"fmt"
 
 	"github.com/iov-one/weave/errors"
 )
 
+// ABCI Response Codes
+// currency takes 2000-2010
 const (
 	CodeInvalidToken = 2000
 )
 
 var (
-	errInvalidTokenName = stderrors.New("Invalid token name")
-	errInvalidSigFigs   = stderrors.New("Invalid significant figures")
-	errDuplicateToken   = stderrors.New("Token with that ticker already exists")
+	codes = errors.RegisterRange("currency", 2000, 2010)
+
+	InvalidTokenErr = codes.Register(CodeInvalidToken, "invalid token")
 )
 
 func ErrInvalidSigFigs(figs int32) error {
-	msg := fmt.Sprintf("%d", figs)
-	return errors.WithLog(msg, errInvalidSigFigs, CodeInvalidToken)
+	return InvalidTokenErr.New(fmt.Sprintf("invalid significant figures: %d", figs))
 }
 
 func ErrInvalidTokenName(name string) error {
-	return errors.WithLog(name, errInvalidTokenName, CodeInvalidToken)
+	return InvalidTokenErr.New("invalid token name: " + name)
 }
 
 func ErrDuplicateToken(name string) error {
-	return errors.WithLog(name, errDuplicateToken, CodeInvalidToken)
+	return InvalidTokenErr.New("token with that ticker already exists: " + name)
+}
+
+func ErrUnknownToken(ticker string) error {
+	return InvalidTokenErr.New("unknown token: " + ticker)
+}
+
+func ErrTooPrecise(value fmt.Stringer, figs int32) error {
+	return InvalidTokenErr.New(fmt.Sprintf("value more precise than significant figures: %s (%d significant figures)", value, figs))
+}
+
+func ErrInvalidDecimals(value fmt.Stringer, figs int32) error {
+	return InvalidTokenErr.New(fmt.Sprintf("value does not use the decimals of the token: %s (%d decimals)", value, figs))
 }
@@ -45,7 +45,7 @@ func (h *TokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	if err != nil {
 		return res, err
 	}
-	obj := NewTokenInfo(msg.Ticker, msg.Name, msg.SigFigs)
+	obj := NewTokenInfo(msg.TokenID(), msg.Name, msg.SigFigs)
 	return res, h.bucket.Save(db, obj)
 }
 
@@ -69,11 +69,11 @@ func (h *TokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 	}
 
 	// Token can be registered only once and must not be updated.
-	switch obj, err := h.bucket.Get(db, msg.Ticker); {
+	switch obj, err := h.bucket.Get(db, msg.TokenID()); {
 	case err != nil:
 		return nil, err
 	case obj != nil:
-		return nil, ErrDuplicateToken(msg.Ticker)
+		return nil, ErrDuplicateToken(msg.TokenID())
 	}
 
 	return msg, nil
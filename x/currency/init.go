@@ -15,6 +15,7 @@ var _ weave.Initializer = (*Initializer)(nil)
 func (*Initializer) FromGenesis(opts weave.Options, db weave.KVStore) error {
 	var tokens []struct {
 		Ticker  string `json:"ticker"`
+		Issuer  string `json:"issuer"`
 		Name    string `json:"name"`
 		SigFigs int32  `json:"sig_figs"`
 	}
@@ -24,7 +25,7 @@ func (*Initializer) FromGenesis(opts weave.Options, db weave.KVStore) error {
 
 	bucket := NewTokenInfoBucket()
 	for _, t := range tokens {
-		obj := NewTokenInfo(t.Ticker, t.Name, t.SigFigs)
+		obj := NewTokenInfo(tokenID(t.Issuer, t.Ticker), t.Name, t.SigFigs)
 		if err := bucket.Save(db, obj); err != nil {
 			return err
 		}
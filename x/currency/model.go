@@ -2,15 +2,18 @@ package currency
 
 import (
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 const (
 	minSigFigs = 0
-	maxSigFigs = 9
+	maxSigFigs = int32(x.MaxDecimals)
 )
 
 var isTokenName = regexp.MustCompile(`^[A-Za-z0-9 \-_:]{3,32}$`).MatchString
@@ -18,9 +21,10 @@ var isTokenName = regexp.MustCompile(`^[A-Za-z0-9 \-_:]{3,32}$`).MatchString
 var _ orm.CloneableData = (*TokenInfo)(nil)
 
 // NewTokenInfo returns a new instance of Token Info, as represented by orm
-// object.
-func NewTokenInfo(ticker, name string, sigFigs int32) orm.Object {
-	return orm.NewSimpleObj([]byte(ticker), &TokenInfo{
+// object. The id is the ticker, or "<issuer>/<ticker>" for a token of
+// another chain.
+func NewTokenInfo(id, name string, sigFigs int32) orm.Object {
+	return orm.NewSimpleObj([]byte(id), &TokenInfo{
 		Name:    name,
 		SigFigs: sigFigs,
 	})
@@ -36,6 +40,29 @@ func (t *TokenInfo) Validate() error {
 	return nil
 }
 
+// ValidateCoin returns an error if the coin has more decimal places
+// than the significant figures of the token allow.
+func (t *TokenInfo) ValidateCoin(c x.Coin) error {
+	if !c.WithinSigFigs(t.SigFigs) {
+		return ErrTooPrecise(c, t.SigFigs)
+	}
+	return nil
+}
+
+// ValidateAmount returns an error if the amount does not use the
+// decimals of the token, which are its significant figures, or has
+// more decimal places than they allow. Amounts of coins, which always
+// have x.CoinDecimals, are accepted as well.
+func (t *TokenInfo) ValidateAmount(a x.Amount) error {
+	if a.Decimals != uint32(t.SigFigs) && a.Decimals != x.CoinDecimals {
+		return ErrInvalidDecimals(&a, t.SigFigs)
+	}
+	if !a.WithinSigFigs(t.SigFigs) {
+		return ErrTooPrecise(&a, t.SigFigs)
+	}
+	return nil
+}
+
 func (t *TokenInfo) Copy() orm.CloneableData {
 	return &TokenInfo{
 		Name:    t.Name,
@@ -43,8 +70,9 @@ func (t *TokenInfo) Copy() orm.CloneableData {
 	}
 }
 
-// TockenInfoBucket stores TokenInfo instances, using ticker name (currency
-// symbol) as the key.
+// TockenInfoBucket stores TokenInfo instances, using the token ID as the
+// key: the ticker (currency symbol) for tokens of this chain, or
+// "<issuer>/<ticker>" for tokens of other chains, like x.Coin.ID.
 type TokenInfoBucket struct {
 	orm.Bucket
 }
@@ -55,16 +83,136 @@ func NewTokenInfoBucket() *TokenInfoBucket {
 	}
 }
 
-func (b *TokenInfoBucket) Get(db weave.KVStore, ticker string) (orm.Object, error) {
-	return b.Bucket.Get(db, []byte(ticker))
+func (b *TokenInfoBucket) Get(db weave.KVStore, id string) (orm.Object, error) {
+	return b.Bucket.Get(db, []byte(id))
 }
 
 func (b *TokenInfoBucket) Save(db weave.KVStore, obj orm.Object) error {
 	if _, ok := obj.Value().(*TokenInfo); !ok {
 		return orm.ErrInvalidObject(obj.Value())
 	}
-	if n := string(obj.Key()); !x.IsCC(n) {
+	if n := string(obj.Key()); !isTokenID(n) {
 		return x.ErrInvalidCurrency(n)
 	}
 	return b.Bucket.Save(db, obj)
 }
+
+// ValidateCoin ensures that the token of the coin is registered and the
+// value is not more precise than the token allows.
+func (b *TokenInfoBucket) ValidateCoin(db weave.KVStore, c x.Coin) error {
+	info, err := b.tokenInfo(db, c.ID())
+	if err != nil {
+		return err
+	}
+	return info.ValidateCoin(c)
+}
+
+// ValidateAmount ensures that the token of the amount is registered and
+// the value is not more precise than the token allows.
+func (b *TokenInfoBucket) ValidateAmount(db weave.KVStore, a x.Amount) error {
+	info, err := b.tokenInfo(db, a.ID())
+	if err != nil {
+		return err
+	}
+	return info.ValidateAmount(a)
+}
+
+// tokenInfo loads the TokenInfo of a token, failing if it is not
+// registered.
+func (b *TokenInfoBucket) tokenInfo(db weave.KVStore, id string) (*TokenInfo, error) {
+	obj, err := b.Get(db, id)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, ErrUnknownToken(id)
+	}
+	return obj.Value().(*TokenInfo), nil
+}
+
+// isIssuer matches the issuer of a token, as read by x.ParseCoin
+var isIssuer = regexp.MustCompile(`^[^\s/]+$`).MatchString
+
+// tokenID returns the ID of a token, like x.Coin.ID
+func tokenID(issuer, ticker string) string {
+	if issuer == "" {
+		return ticker
+	}
+	return issuer + "/" + ticker
+}
+
+// isTokenID returns true if id is a valid token ID, see tokenID
+func isTokenID(id string) bool {
+	i := strings.LastIndex(id, "/")
+	if i == -1 {
+		return x.IsCC(id)
+	}
+	return isIssuer(id[:i]) && x.IsCC(id[i+1:])
+}
+
+// Migrations returns the state migrations required by this package,
+// an application using the Controller must apply them, see
+// orm.Migrations.
+func Migrations() []orm.Migration {
+	return []orm.Migration{
+		// chains could hold tokens before transfers required them
+		// to be registered
+		{Name: "currency_held_tokens", Run: registerHeldTokens},
+	}
+}
+
+// registerHeldTokens registers every token held in a cash wallet that
+// is not registered yet, including tokens of other chains, so that it
+// can still be moved. Its name is the ticker. Its significant figures
+// are the 9 of a coin, or the decimals of a held amount if more, so
+// that every held value remains valid.
+func registerHeldTokens(db weave.KVStore) error {
+	tokens := make(map[string]*TokenInfo)
+	err := cash.NewBucket().Iterate(db, nil, nil, func(obj orm.Object) error {
+		for _, a := range cash.Balance(cash.AsCoinage(obj)) {
+			info, ok := tokens[a.ID()]
+			if !ok {
+				info = &TokenInfo{Name: a.Ticker, SigFigs: int32(x.CoinDecimals)}
+				tokens[a.ID()] = info
+			}
+			if int32(a.Decimals) > info.SigFigs {
+				info.SigFigs = int32(a.Decimals)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(tokens))
+	for id := range tokens {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	bucket := NewTokenInfoBucket()
+	for _, id := range ids {
+		obj, err := bucket.Get(db, id)
+		if err != nil {
+			return err
+		}
+		if obj != nil {
+			continue
+		}
+		if err := bucket.Save(db, orm.NewSimpleObj([]byte(id), tokens[id])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseCoin parses a human readable coin, like x.ParseCoin, and ensures
+// that its token is registered and the value is not more precise than
+// the token allows.
+func (b *TokenInfoBucket) ParseCoin(db weave.KVStore, s string) (x.Coin, error) {
+	c, err := x.ParseCoin(s)
+	if err != nil {
+		return c, err
+	}
+	return c, b.ValidateCoin(db, c)
+}
@@ -1,10 +1,14 @@
 package currency
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestTokenInfoBucketQuery(t *testing.T) {
@@ -50,3 +54,95 @@ func TestTokenInfoBucketQuery(t *testing.T) {
 		t.Fatal("unexpected query result")
 	}
 }
+
+func TestTokenInfoBucketParseCoin(t *testing.T) {
+	bucket := NewTokenInfoBucket()
+	db := store.MemStore()
+	if err := bucket.Save(db, NewTokenInfo("DOGE", "Doge Coin", 2)); err != nil {
+		t.Fatalf("cannot register doge: %s", err)
+	}
+
+	cases := map[string]struct {
+		in      string
+		wantErr bool
+	}{
+		"registered":      {in: "1.25 DOGE"},
+		"too precise":     {in: "1.255 DOGE", wantErr: true},
+		"unknown token":   {in: "1 XYZ", wantErr: true},
+		"not a coin":      {in: "one DOGE", wantErr: true},
+		"no fraction":     {in: "1000 DOGE"},
+		"trailing zeroes": {in: "1.2500 DOGE"},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := bucket.ParseCoin(db, tc.in)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("want error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+	_, b := helpers.MakeKey()
+	_, c := helpers.MakeKey()
+
+	db := store.MemStore()
+	bucket := NewTokenInfoBucket()
+	if err := bucket.Save(db, NewTokenInfo("DOGE", "Doge Coin", 2)); err != nil {
+		t.Fatalf("cannot register doge: %s", err)
+	}
+	ctrl := cash.NewController(cash.NewBucket())
+	for _, coin := range []x.Coin{
+		x.NewCoin(1, 500000000, "IOV"),
+		x.NewCoin(3, 0, "DOGE"),
+	} {
+		if err := ctrl.IssueCoins(db, a.Address(), coin); err != nil {
+			t.Fatalf("cannot issue %s: %s", coin, err)
+		}
+	}
+	if err := ctrl.IssueCoins(db, b.Address(), x.NewCoin(0, 1, "IOV")); err != nil {
+		t.Fatalf("cannot issue: %s", err)
+	}
+	if err := ctrl.IssueCoins(db, c.Address(), x.NewCoin(7, 0, "ETH").WithIssuer("chain-1")); err != nil {
+		t.Fatalf("cannot issue: %s", err)
+	}
+	wei := x.Amount{Value: "1", Decimals: 18, Ticker: "WEI", Issuer: "chain-1"}
+	if err := ctrl.IssueAmount(db, c.Address(), wei); err != nil {
+		t.Fatalf("cannot issue: %s", err)
+	}
+
+	if _, err := orm.Migrations(Migrations()).Tick(context.Background(), db); err != nil {
+		t.Fatalf("migration failed: %s", err)
+	}
+
+	// held tokens are registered, existing ones are not changed
+	want := map[string]TokenInfo{
+		"DOGE":        {Name: "Doge Coin", SigFigs: 2},
+		"IOV":         {Name: "IOV", SigFigs: 9},
+		"chain-1/ETH": {Name: "ETH", SigFigs: 9},
+		"chain-1/WEI": {Name: "WEI", SigFigs: 18},
+	}
+	for id, info := range want {
+		obj, err := bucket.Get(db, id)
+		if err != nil {
+			t.Fatalf("cannot get %s: %s", id, err)
+		}
+		if obj == nil || !reflect.DeepEqual(obj.Value(), &info) {
+			t.Fatalf("want %s registered as %v, got %v", id, info, obj)
+		}
+	}
+	// and the held tokens can be moved
+	currencyCtrl := NewController(ctrl)
+	if err := currencyCtrl.MoveCoins(db, b.Address(), a.Address(), x.NewCoin(0, 1, "IOV")); err != nil {
+		t.Fatalf("cannot move IOV: %s", err)
+	}
+	if err := currencyCtrl.MoveCoins(db, c.Address(), a.Address(), x.NewCoin(7, 0, "ETH").WithIssuer("chain-1")); err != nil {
+		t.Fatalf("cannot move chain-1/ETH: %s", err)
+	}
+	if err := currencyCtrl.MoveAmount(db, c.Address(), a.Address(), wei); err != nil {
+		t.Fatalf("cannot move chain-1/WEI: %s", err)
+	}
+}
@@ -12,8 +12,8 @@ func (NewTokenInfoMsg) Path() string {
 }
 
 func (t *NewTokenInfoMsg) Validate() error {
-	if !x.IsCC(t.Ticker) {
-		return x.ErrInvalidCurrency(t.Ticker)
+	if !isTokenID(t.TokenID()) {
+		return x.ErrInvalidCurrency(t.TokenID())
 	}
 	if !isTokenName(t.Name) {
 		return ErrInvalidTokenName(t.Name)
@@ -23,3 +23,8 @@ func (t *NewTokenInfoMsg) Validate() error {
 	}
 	return nil
 }
+
+// TokenID returns the ID of the registered token, like x.Coin.ID
+func (t *NewTokenInfoMsg) TokenID() string {
+	return tokenID(t.Issuer, t.Ticker)
+}
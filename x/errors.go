@@ -1,22 +1,19 @@
 package x
 
-import (
-	"fmt"
-
-	"github.com/iov-one/weave/errors"
-)
+import "github.com/iov-one/weave/errors"
 
 // ABCI Response Codes
+// x/coins reserves 30 ~ 39, x uses 30 ~ 31.
 const (
 	CodeInvalidCurrency uint32 = 30
 	CodeInvalidCoin            = 31
 )
 
 var (
-	errInvalidCurrency = fmt.Errorf("Invalid currency code")
-	errOutOfRange      = fmt.Errorf("Overflow coin range")
-	errMismatchedSign  = fmt.Errorf("Mismatched sign")
-	errInvalidWallet   = fmt.Errorf("Invalid wallet")
+	codes = errors.RegisterRange("x", 30, 31)
+
+	InvalidCurrencyErr = codes.Register(CodeInvalidCurrency, "invalid currency")
+	InvalidCoinErr     = codes.Register(CodeInvalidCoin, "invalid coin")
 )
 
 // ErrInvalidCurrency takes one or two currencies
@@ -26,25 +23,32 @@ func ErrInvalidCurrency(cur string, other ...string) error {
 	if len(other) > 0 {
 		cur += " vs. " + other[0]
 	}
-	return errors.WithLog(cur, errInvalidCurrency, CodeInvalidCurrency)
+	return InvalidCurrencyErr.New(cur)
 }
 func IsInvalidCurrencyErr(err error) bool {
-	return errors.IsSameError(errInvalidCurrency, err)
+	return errors.HasErrorCode(err, CodeInvalidCurrency)
 }
 
-//------ various invalid signatures ----
-// all will match IsInvalidSignatureError
+//------ various invalid coins ----
+// all will match IsInvalidCoinErr
 
 func ErrOutOfRange(coin Coin) error {
-	msg := coin.String()
-	return errors.WithLog(msg, errOutOfRange, CodeInvalidCoin)
+	return InvalidCoinErr.New("out of range: " + coin.String())
 }
 func ErrMismatchedSign(coin Coin) error {
-	msg := coin.String()
-	return errors.WithLog(msg, errMismatchedSign, CodeInvalidCoin)
+	return InvalidCoinErr.New("mismatched sign: " + coin.String())
+}
+func ErrAmountOutOfRange(amount Amount) error {
+	return InvalidCoinErr.New("out of range: " + amount.String())
+}
+func ErrInvalidAmount(amount Amount, reason string) error {
+	return InvalidCoinErr.New(reason + ": " + amount.String())
+}
+func ErrInvalidCoinFormat(s string) error {
+	return InvalidCoinErr.New("invalid format: " + s)
 }
 func ErrInvalidWallet(msg string) error {
-	return errors.WithLog(msg, errInvalidWallet, CodeInvalidCoin)
+	return InvalidCoinErr.New("invalid wallet: " + msg)
 }
 func IsInvalidCoinErr(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidCoin)
@@ -53,6 +53,9 @@ type Escrow struct {
 	Timeout int64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// precise_amount holds the tokens that cannot be
+	// represented by a Coin, see x.Amount
+	PreciseAmount []*x.Amount `protobuf:"bytes,7,rep,name=precise_amount,json=preciseAmount" json:"precise_amount,omitempty"`
 }
 
 func (m *Escrow) Reset()                    { *m = Escrow{} }
@@ -102,6 +105,13 @@ func (m *Escrow) GetMemo() string {
 	return ""
 }
 
+func (m *Escrow) GetPreciseAmount() []*x.Amount {
+	if m != nil {
+		return m.PreciseAmount
+	}
+	return nil
+}
+
 // CreateEscrowMsg is a request to create an Escrow with some tokens.
 // If sender is not defined, it defaults to the first signer
 // The rest must be defined
@@ -116,6 +126,9 @@ type CreateEscrowMsg struct {
 	Timeout int64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// precise_amount may contain tokens that cannot be
+	// represented by a Coin, in addition to amount
+	PreciseAmount []*x.Amount `protobuf:"bytes,7,rep,name=precise_amount,json=preciseAmount" json:"precise_amount,omitempty"`
 }
 
 func (m *CreateEscrowMsg) Reset()                    { *m = CreateEscrowMsg{} }
@@ -165,13 +178,21 @@ func (m *CreateEscrowMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateEscrowMsg) GetPreciseAmount() []*x.Amount {
+	if m != nil {
+		return m.PreciseAmount
+	}
+	return nil
+}
+
 // ReleaseEscrowMsg releases the content to the recipient.
 // Must be authorized by sender or arbiter.
 // If amount not provided, defaults to entire escrow,
 // May be a subset of the current balance.
 type ReleaseEscrowMsg struct {
-	EscrowId []byte    `protobuf:"bytes,1,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
-	Amount   []*x.Coin `protobuf:"bytes,2,rep,name=amount" json:"amount,omitempty"`
+	EscrowId      []byte      `protobuf:"bytes,1,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Amount        []*x.Coin   `protobuf:"bytes,2,rep,name=amount" json:"amount,omitempty"`
+	PreciseAmount []*x.Amount `protobuf:"bytes,3,rep,name=precise_amount,json=preciseAmount" json:"precise_amount,omitempty"`
 }
 
 func (m *ReleaseEscrowMsg) Reset()                    { *m = ReleaseEscrowMsg{} }
@@ -193,6 +214,13 @@ func (m *ReleaseEscrowMsg) GetAmount() []*x.Coin {
 	return nil
 }
 
+func (m *ReleaseEscrowMsg) GetPreciseAmount() []*x.Amount {
+	if m != nil {
+		return m.PreciseAmount
+	}
+	return nil
+}
+
 // ReturnEscrowMsg returns the content to the sender.
 // Must be authorized by the sender or an expired timeout
 type ReturnEscrowMsg struct {
@@ -319,6 +347,18 @@ func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, msg := range m.PreciseAmount {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -378,6 +418,18 @@ func (m *CreateEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, msg := range m.PreciseAmount {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -414,6 +466,18 @@ func (m *ReleaseEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, msg := range m.PreciseAmount {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -520,6 +584,12 @@ func (m *Escrow) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, e := range m.PreciseAmount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -551,6 +621,12 @@ func (m *CreateEscrowMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, e := range m.PreciseAmount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -567,6 +643,12 @@ func (m *ReleaseEscrowMsg) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if len(m.PreciseAmount) > 0 {
+		for _, e := range m.PreciseAmount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -816,6 +898,37 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreciseAmount = append(m.PreciseAmount, &x.Amount{})
+			if err := m.PreciseAmount[len(m.PreciseAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1038,6 +1151,37 @@ func (m *CreateEscrowMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreciseAmount = append(m.PreciseAmount, &x.Amount{})
+			if err := m.PreciseAmount[len(m.PreciseAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1150,6 +1294,37 @@ func (m *ReleaseEscrowMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreciseAmount = append(m.PreciseAmount, &x.Amount{})
+			if err := m.PreciseAmount[len(m.PreciseAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1534,26 +1709,28 @@ var (
 func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0xbf, 0x69, 0xfa, 0xa5, 0xf6, 0x2a, 0xb4, 0x0c, 0x52, 0x06, 0x95, 0x18, 0xb2, 0x90,
-	0x6c, 0x4c, 0x40, 0xdf, 0xc0, 0xe2, 0xc2, 0x85, 0x50, 0x02, 0xae, 0x25, 0x9d, 0x5c, 0xea, 0x80,
-	0x99, 0x29, 0x33, 0x93, 0xb6, 0x2f, 0xe0, 0xde, 0xb7, 0x10, 0x7c, 0x12, 0x97, 0x3e, 0x82, 0xd4,
-	0x17, 0x91, 0x26, 0xa9, 0x0d, 0xe2, 0xbf, 0xa5, 0xbb, 0x7b, 0xcf, 0x4d, 0xee, 0xfd, 0x71, 0xe6,
-	0xc0, 0xee, 0x22, 0x46, 0xc3, 0xb5, 0x9a, 0xc7, 0x5c, 0x65, 0xc8, 0xa3, 0xa9, 0x56, 0x56, 0x51,
-	0xb7, 0xd2, 0xf6, 0x8e, 0x26, 0xc2, 0xde, 0x14, 0xe3, 0x88, 0xab, 0x3c, 0x16, 0x6a, 0x76, 0xac,
-	0x24, 0xc6, 0x73, 0x4c, 0x67, 0x18, 0x2f, 0x9a, 0xdf, 0x07, 0x0f, 0x04, 0xdc, 0xf3, 0xf2, 0x17,
-	0x3a, 0x00, 0xd7, 0xa0, 0xcc, 0x50, 0x33, 0xe2, 0x93, 0x70, 0x27, 0xa9, 0x3b, 0xca, 0xa0, 0x93,
-	0xea, 0xb1, 0xb0, 0xa8, 0x59, 0xab, 0x1c, 0xac, 0x5b, 0x7a, 0x00, 0x5d, 0x8d, 0x5c, 0x4c, 0x05,
-	0x4a, 0xcb, 0x9c, 0x72, 0xb6, 0x11, 0xe8, 0x21, 0xb8, 0x69, 0xae, 0x0a, 0x69, 0x59, 0xdb, 0x77,
-	0xc2, 0xed, 0x93, 0x4e, 0xb4, 0x88, 0x86, 0x4a, 0xc8, 0xa4, 0x96, 0x57, 0x8b, 0xad, 0xc8, 0x51,
-	0x15, 0x96, 0xfd, 0xf7, 0x49, 0xe8, 0x24, 0xeb, 0x96, 0x52, 0x68, 0xe7, 0x98, 0x2b, 0xe6, 0xfa,
-	0x24, 0xec, 0x26, 0x65, 0x1d, 0x3c, 0x12, 0xe8, 0x0d, 0x35, 0xa6, 0x16, 0x2b, 0xde, 0x4b, 0x33,
-	0xa1, 0x7d, 0x70, 0x8c, 0xe6, 0x35, 0xef, 0xaa, 0xfc, 0x2b, 0xb0, 0x23, 0xe8, 0x27, 0x78, 0x8b,
-	0xa9, 0x69, 0xc0, 0xee, 0x43, 0xb7, 0x7a, 0x9c, 0x6b, 0x91, 0xd5, 0xc8, 0x5b, 0x95, 0x70, 0x91,
-	0x35, 0xee, 0xb7, 0x3e, 0xbd, 0x1f, 0x44, 0xd0, 0x4b, 0xd0, 0x16, 0x5a, 0xfe, 0x6e, 0x61, 0x70,
-	0x47, 0x60, 0x70, 0x35, 0xcd, 0xde, 0xed, 0x1a, 0xa5, 0xda, 0x0a, 0x34, 0x3f, 0x82, 0x6c, 0x52,
-	0xd0, 0xfa, 0x2a, 0x05, 0xce, 0x37, 0xc6, 0xb6, 0x3f, 0x18, 0x7b, 0xd6, 0x7f, 0x5a, 0x7a, 0xe4,
-	0x79, 0xe9, 0x91, 0x97, 0xa5, 0x47, 0xee, 0x5f, 0xbd, 0x7f, 0x63, 0xb7, 0x4c, 0xde, 0xe9, 0x5b,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x40, 0x3e, 0xd4, 0xc1, 0x02, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x4d, 0x4b, 0xeb, 0x40,
+	0x14, 0x7d, 0xd3, 0xf4, 0xa5, 0x2f, 0xf7, 0xa9, 0x2d, 0x83, 0x94, 0xa0, 0x12, 0x43, 0x17, 0x92,
+	0x8d, 0x89, 0xe8, 0x2f, 0xd0, 0xe2, 0xc2, 0x85, 0x20, 0x01, 0xd7, 0x25, 0x4d, 0x2e, 0x75, 0xc0,
+	0x64, 0xc2, 0xcc, 0xa4, 0xcd, 0x5a, 0x70, 0xef, 0xcf, 0x72, 0xe9, 0x56, 0x70, 0x21, 0xf5, 0x8f,
+	0x48, 0x27, 0xa9, 0x2d, 0x7e, 0xd5, 0xad, 0xbb, 0x7b, 0xce, 0xbd, 0x73, 0x38, 0x87, 0x7b, 0x07,
+	0x36, 0xcb, 0x00, 0x65, 0x2c, 0xf8, 0x24, 0x88, 0x79, 0x82, 0xb1, 0x9f, 0x0b, 0xae, 0x38, 0x35,
+	0x2b, 0x6e, 0x6b, 0x6f, 0xc4, 0xd4, 0x55, 0x31, 0xf4, 0x63, 0x9e, 0x06, 0x8c, 0x8f, 0xf7, 0x79,
+	0x86, 0xc1, 0x04, 0xa3, 0x31, 0x06, 0xe5, 0xf2, 0x7c, 0xef, 0x91, 0x80, 0x79, 0xaa, 0x9f, 0xd0,
+	0x2e, 0x98, 0x12, 0xb3, 0x04, 0x85, 0x4d, 0x5c, 0xe2, 0xad, 0x85, 0x35, 0xa2, 0x36, 0xb4, 0x22,
+	0x31, 0x64, 0x0a, 0x85, 0xdd, 0xd0, 0x8d, 0x39, 0xa4, 0x3b, 0x60, 0x09, 0x8c, 0x59, 0xce, 0x30,
+	0x53, 0xb6, 0xa1, 0x7b, 0x0b, 0x82, 0xee, 0x82, 0x19, 0xa5, 0xbc, 0xc8, 0x94, 0xdd, 0x74, 0x0d,
+	0xef, 0xff, 0x61, 0xcb, 0x2f, 0xfd, 0x3e, 0x67, 0x59, 0x58, 0xd3, 0x33, 0x61, 0xc5, 0x52, 0xe4,
+	0x85, 0xb2, 0xff, 0xba, 0xc4, 0x33, 0xc2, 0x39, 0xa4, 0x14, 0x9a, 0x29, 0xa6, 0xdc, 0x36, 0x5d,
+	0xe2, 0x59, 0xa1, 0xae, 0xe9, 0x01, 0x6c, 0xe4, 0x33, 0x71, 0x89, 0x83, 0x5a, 0xb6, 0xa5, 0x65,
+	0x2d, 0xbf, 0xf4, 0x8f, 0x35, 0x11, 0xae, 0xd7, 0x03, 0x15, 0xec, 0x3d, 0x11, 0x68, 0xf7, 0x05,
+	0x46, 0x0a, 0xab, 0x84, 0xe7, 0x72, 0x44, 0x3b, 0x60, 0x48, 0x11, 0xd7, 0x09, 0x67, 0xe5, 0xef,
+	0x8d, 0x77, 0x43, 0xa0, 0x13, 0xe2, 0x35, 0x46, 0x72, 0x29, 0xdf, 0x36, 0x58, 0xd5, 0x05, 0x0c,
+	0x58, 0x52, 0xa7, 0xfc, 0x57, 0x11, 0x67, 0xc9, 0x92, 0xe5, 0xc6, 0xe7, 0x96, 0x3f, 0x9a, 0x30,
+	0x56, 0x98, 0xf0, 0xa1, 0x1d, 0xa2, 0x2a, 0x44, 0xf6, 0x33, 0x0b, 0xbd, 0x5b, 0x02, 0xdd, 0xcb,
+	0x3c, 0x79, 0xdb, 0xc9, 0x45, 0x24, 0x14, 0x43, 0xb9, 0xd2, 0xfa, 0xe2, 0x38, 0x1b, 0x5f, 0x1d,
+	0xa7, 0xf1, 0xcd, 0xf6, 0x9a, 0xef, 0xb6, 0x77, 0xd2, 0xb9, 0x9f, 0x3a, 0xe4, 0x61, 0xea, 0x90,
+	0xe7, 0xa9, 0x43, 0xee, 0x5e, 0x9c, 0x3f, 0x43, 0x53, 0x7f, 0x88, 0xa3, 0xd7, 0x01, 0x00, 0x67,
+	0xde, 0x71, 0x2c, 0x58, 0x03, 0x00, 0x00,
 }
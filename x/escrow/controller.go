@@ -20,38 +20,42 @@ func NewController(cash cash.Controller, bucket Bucket) *controller {
 }
 
 // Deposit transfers the given amounts from source wallet to the escrow account and persist it.
-func (m *controller) Deposit(db weave.KVStore, escrow *Escrow, escrowID []byte, src weave.Address, amounts x.Coins) error {
-	available := x.Coins(escrow.Amount).Clone()
-	err := m.moveCoins(db, src, Condition(escrowID).Address(), amounts)
+func (m *controller) Deposit(db weave.KVStore, escrow *Escrow, escrowID []byte, src weave.Address, amounts x.Amounts) error {
+	available, err := escrow.Balance()
 	if err != nil {
 		return err
 	}
-	for _, c := range amounts {
-		available, err = available.Add(*c)
-		if err != nil {
-			return err
-		}
+	err = m.moveAmounts(db, src, Condition(escrowID).Address(), amounts)
+	if err != nil {
+		return err
 	}
-	escrow.Amount = available
+	available, err = available.Combine(amounts)
+	if err != nil {
+		return err
+	}
+	escrow.Amount, escrow.PreciseAmount = available.Split()
 	return m.bucket.Save(db, orm.NewSimpleObj(escrowID, escrow))
 }
 
 // Deposit transfers the given amounts from escrow account to dest wallet and persist it.
 // If no coins are remaining in the escrow account it is deleted.
-func (m *controller) Withdraw(db weave.KVStore, escrow *Escrow, escrowID []byte, dest weave.Address, amounts x.Coins) error {
-	available := x.Coins(escrow.Amount).Clone()
-	err := m.moveCoins(db, Condition(escrowID).Address(), dest, amounts)
+func (m *controller) Withdraw(db weave.KVStore, escrow *Escrow, escrowID []byte, dest weave.Address, amounts x.Amounts) error {
+	available, err := escrow.Balance()
+	if err != nil {
+		return err
+	}
+	err = m.moveAmounts(db, Condition(escrowID).Address(), dest, amounts)
 	if err != nil {
 		return err
 	}
 	// remove coin from remaining balance
-	for _, c := range amounts {
-		available, err = available.Subtract(*c)
+	for _, a := range amounts {
+		available, err = available.Subtract(*a)
 		if err != nil {
 			return err
 		}
 	}
-	escrow.Amount = available
+	escrow.Amount, escrow.PreciseAmount = available.Split()
 	// if there is something left, just update the balance...
 	if available.IsPositive() {
 		return m.bucket.Save(db, orm.NewSimpleObj(escrowID, escrow))
@@ -60,9 +64,9 @@ func (m *controller) Withdraw(db weave.KVStore, escrow *Escrow, escrowID []byte,
 	return m.bucket.Delete(db, escrowID)
 }
 
-func (m *controller) moveCoins(db weave.KVStore, src weave.Address, dest weave.Address, amounts x.Coins) error {
-	for _, c := range amounts {
-		err := m.cash.MoveCoins(db, src, dest, *c)
+func (m *controller) moveAmounts(db weave.KVStore, src weave.Address, dest weave.Address, amounts x.Amounts) error {
+	for _, a := range amounts {
+		err := cash.MoveAmount(m.cash, db, src, dest, *a)
 		if err != nil {
 			// this will rollback the half-finished tx
 			return err
@@ -20,37 +20,26 @@ const (
 )
 
 var (
-	errMissingArbiter       = fmt.Errorf("Missing Arbiter")
-	errMissingSender        = fmt.Errorf("Missing Src")
-	errMissingRecipient     = fmt.Errorf("Missing Recipient")
-	errMissingAllConditions = fmt.Errorf("Missing All Conditions")
+	codes = errors.RegisterRange("escrow", 1010, 1020)
 
-	errInvalidMemo     = fmt.Errorf("Memo field too long")
-	errInvalidTimeout  = fmt.Errorf("Invalid Timeout")
-	errInvalidEscrowID = fmt.Errorf("Invalid Escrow ID")
-
-	errNoSuchEscrow = fmt.Errorf("No Escrow with this ID")
-
-	errEscrowExpired    = fmt.Errorf("Escrow already expired")
-	errEscrowNotExpired = fmt.Errorf("Escrow not yet expired")
-
-	// errInvalidIndex      = fmt.Errorf("Cannot calculate index")
-	// errInvalidWalletName = fmt.Errorf("Invalid name for a wallet")
-	// errChangeWalletName  = fmt.Errorf("Wallet already has a name")
-	// errNoSuchWallet      = fmt.Errorf("No wallet exists with this address")
+	NoEscrowErr         = codes.Register(CodeNoEscrow, "no escrow")
+	MissingConditionErr = codes.Register(CodeMissingCondition, "missing condition")
+	InvalidConditionErr = codes.Register(CodeInvalidCondition, "invalid condition")
+	InvalidMetadataErr  = codes.Register(CodeInvalidMetadata, "invalid metadata")
+	InvalidHeightErr    = codes.Register(CodeInvalidHeight, "invalid height")
 )
 
 func ErrMissingArbiter() error {
-	return errors.WithCode(errMissingArbiter, CodeMissingCondition)
+	return MissingConditionErr.New("arbiter")
 }
 func ErrMissingSender() error {
-	return errors.WithCode(errMissingSender, CodeMissingCondition)
+	return MissingConditionErr.New("sender")
 }
 func ErrMissingRecipient() error {
-	return errors.WithCode(errMissingRecipient, CodeMissingCondition)
+	return MissingConditionErr.New("recipient")
 }
 func ErrMissingAllConditions() error {
-	return errors.WithCode(errMissingAllConditions, CodeMissingCondition)
+	return MissingConditionErr.New("all conditions")
 }
 func IsMissingConditionErr(err error) bool {
 	return errors.HasErrorCode(err, CodeMissingCondition)
@@ -64,36 +53,32 @@ func IsInvalidConditionErr(err error) bool {
 }
 
 func ErrInvalidMemo(memo string) error {
-	return errors.WithLog(memo, errInvalidMemo, CodeInvalidMetadata)
+	return InvalidMetadataErr.New("memo too long: " + memo)
 }
 func ErrInvalidTimeout(timeout int64) error {
-	msg := fmt.Sprintf("%d", timeout)
-	return errors.WithLog(msg, errInvalidTimeout, CodeInvalidMetadata)
+	return InvalidMetadataErr.New(fmt.Sprintf("invalid timeout: %d", timeout))
 }
 func ErrInvalidEscrowID(id []byte) error {
 	msg := "(nil)"
 	if len(id) > 0 {
 		msg = fmt.Sprintf("%X", id)
 	}
-	return errors.WithLog(msg, errInvalidEscrowID, CodeInvalidMetadata)
+	return InvalidMetadataErr.New("invalid escrow id: " + msg)
 }
 func IsInvalidMetadataErr(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidMetadata)
 }
 
 func ErrNoSuchEscrow(id []byte) error {
-	msg := fmt.Sprintf("%X", id)
-	return errors.WithLog(msg, errNoSuchEscrow, CodeNoEscrow)
+	return NoEscrowErr.New(fmt.Sprintf("%X", id))
 }
 func IsNoSuchEscrowErr(err error) bool {
 	return errors.HasErrorCode(err, CodeNoEscrow)
 }
 
 func ErrEscrowExpired(timeout int64) error {
-	msg := fmt.Sprintf("%d", timeout)
-	return errors.WithLog(msg, errEscrowExpired, CodeInvalidHeight)
+	return InvalidHeightErr.New(fmt.Sprintf("escrow expired at %d", timeout))
 }
 func ErrEscrowNotExpired(timeout int64) error {
-	msg := fmt.Sprintf("%d", timeout)
-	return errors.WithLog(msg, errEscrowNotExpired, CodeInvalidHeight)
+	return InvalidHeightErr.New(fmt.Sprintf("escrow not expired until %d", timeout))
 }
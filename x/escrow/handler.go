@@ -17,8 +17,8 @@ const (
 )
 
 type escrowOperations interface {
-	Deposit(db weave.KVStore, escrow *Escrow, escrowID []byte, src weave.Address, amounts x.Coins) error
-	Withdraw(db weave.KVStore, escrow *Escrow, escrowID []byte, dest weave.Address, amounts x.Coins) error
+	Deposit(db weave.KVStore, escrow *Escrow, escrowID []byte, src weave.Address, amounts x.Amounts) error
+	Withdraw(db weave.KVStore, escrow *Escrow, escrowID []byte, dest weave.Address, amounts x.Amounts) error
 }
 
 // RegisterRoutes will instantiate and register
@@ -88,8 +88,12 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore,
 		Timeout:   msg.Timeout,
 		Memo:      msg.Memo,
 	}
+	amounts, err := msg.Amounts()
+	if err != nil {
+		return res, err
+	}
 	obj := h.bucket.Build(db, escrow)
-	if err := h.ops.Deposit(db, escrow, obj.Key(), sender, msg.Amount); err != nil {
+	if err := h.ops.Deposit(db, escrow, obj.Key(), sender, amounts); err != nil {
 		return res, err
 	}
 	// return id of escrow to use in future calls
@@ -171,8 +175,14 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore,
 	}
 
 	// use amount in message, or
-	request := x.Coins(msg.Amount)
-	available := x.Coins(escrow.Amount)
+	request, err := msg.Amounts()
+	if err != nil {
+		return res, err
+	}
+	available, err := escrow.Balance()
+	if err != nil {
+		return res, err
+	}
 	if len(request) == 0 {
 		request = available
 
@@ -189,7 +199,7 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore,
 		return res, err
 	}
 
-	if x.Coins(escrow.Amount).IsPositive() {
+	if len(escrow.Amount) != 0 || len(escrow.PreciseAmount) != 0 {
 		res.Data = key
 	}
 	return res, err
@@ -271,8 +281,12 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore,
 	}
 
 	// move the money from escrow to recipient
+	available, err := escrow.Balance()
+	if err != nil {
+		return res, err
+	}
 	dest := weave.Address(escrow.Sender)
-	if err := h.ops.Withdraw(db, escrow, key, dest, escrow.Amount); err != nil {
+	if err := h.ops.Withdraw(db, escrow, key, dest, available); err != nil {
 		return res, err
 	}
 	// returns error if Delete failed
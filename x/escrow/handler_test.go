@@ -13,6 +13,7 @@ import (
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/hashlock"
+	"github.com/iov-one/weave/x/multisig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -123,6 +124,22 @@ func TestHandler(t *testing.T) {
 					},
 					NewBucket().Bucket,
 				},
+				// make sure sender_recipient index works for both fields
+				{
+					"/escrows/sender_recipient", "", orm.CompoundKey(a.Address(), b.Address()), false,
+					[]orm.Object{
+						NewEscrow(id(1), a.Address(), b.Address(), c, some, Timeout, ""),
+					},
+					NewBucket().Bucket,
+				},
+				// and for the sender only
+				{
+					"/escrows/sender_recipient", "prefix", orm.CompoundKey(a.Address()), false,
+					[]orm.Object{
+						NewEscrow(id(1), a.Address(), b.Address(), c, some, Timeout, ""),
+					},
+					NewBucket().Bucket,
+				},
 				// make sure arbiter index works
 				{
 					"/escrows/arbiter", "", c, false,
@@ -740,6 +757,71 @@ func TestAtomicSwap(t *testing.T) {
 	}
 }
 
+// TestPreciseAmount escrows tokens that cannot be represented
+// by a Coin, together with regular coins
+func TestPreciseAmount(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+	_, b := helpers.MakeKey()
+	_, arbiter := helpers.MakeKey()
+
+	bank := cash.NewBucket()
+	ctrl := cash.NewController(bank)
+	r := app.NewRouter()
+	RegisterRoutes(r, authenticator(), ctrl)
+	bucket := NewBucket()
+
+	db := store.MemStore()
+	eth := x.Amount{Value: "3000000000000000001", Decimals: 18, Ticker: "ETH"}
+	require.NoError(t, ctrl.IssueAmount(db, a.Address(), eth))
+	require.NoError(t, ctrl.IssueCoins(db, a.Address(), x.NewCoin(10, 0, "FOO")))
+
+	create := action{
+		perms:  []weave.Condition{a},
+		msg:    NewCreateMsg(a.Address(), b.Address(), arbiter, mustCombineCoins(x.NewCoin(4, 0, "FOO")), Timeout, ""),
+		height: 1000,
+	}
+	create.msg.(*CreateEscrowMsg).PreciseAmount = x.Amounts{&eth}
+	res, err := r.Deliver(create.ctx(), db, create.tx())
+	require.NoError(t, err)
+	escrowID := res.Data
+
+	obj, err := bucket.Get(db, escrowID)
+	require.NoError(t, err)
+	escrow := AsEscrow(obj)
+	assert.Equal(t, []*x.Coin{{Whole: 4, Ticker: "FOO"}}, escrow.Amount)
+	assert.Equal(t, []*x.Amount{&eth}, escrow.PreciseAmount)
+
+	// releasing 1 ETH leaves a precise balance in the escrow
+	oneEth := x.Amount{Value: "1", Ticker: "ETH"}
+	release := action{
+		perms:  []weave.Condition{arbiter},
+		msg:    &ReleaseEscrowMsg{EscrowId: escrowID, PreciseAmount: x.Amounts{&oneEth}},
+		height: 2000,
+	}
+	_, err = r.Deliver(release.ctx(), db, release.tx())
+	require.NoError(t, err)
+	obj, err = bank.Get(db, b.Address())
+	require.NoError(t, err)
+	assert.Equal(t, x.Coins{&x.Coin{Whole: 1, Ticker: "ETH"}}, cash.AsCoins(obj))
+
+	// release everything that is left
+	release.msg = &ReleaseEscrowMsg{EscrowId: escrowID}
+	_, err = r.Deliver(release.ctx(), db, release.tx())
+	require.NoError(t, err)
+	obj, err = bucket.Get(db, escrowID)
+	require.NoError(t, err)
+	assert.Nil(t, obj)
+
+	obj, err = bank.Get(db, b.Address())
+	require.NoError(t, err)
+	want := x.Amounts{
+		&x.Amount{Value: "3000000000000000001", Decimals: 18, Ticker: "ETH"},
+		&x.Amount{Value: "4", Ticker: "FOO"},
+	}
+	assert.True(t, want.Equals(cash.Balance(cash.AsCoinage(obj))))
+}
+
 // --- cut and paste from hashlock/decorator_test.go :(
 
 // PreimageTx fulfills the HashKeyTx interface to satisfy the decorator
@@ -842,3 +924,72 @@ func mo(obj orm.Object, err error) orm.Object {
 	}
 	return obj
 }
+
+func TestMigrations(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+	_, b := helpers.MakeKey()
+
+	db := store.MemStore()
+	contract := multisig.NewContractBucket().Build(db, &multisig.Contract{
+		Sigs:                [][]byte{a.Address()},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+	})
+	require.NoError(t, multisig.NewContractBucket().SaveAt(db, 0, contract))
+	c := multisig.MultiSigCondition(contract.Key())
+
+	// an escrow created before the sender_recipient index existed
+	old := orm.NewBucket(BucketName, orm.NewSimpleObj(nil, new(Escrow))).
+		WithIndex("sender", idxSender, false).
+		WithIndex("recipient", idxRecipient, false).
+		WithIndex("arbiter", idxArbiter, false)
+	esc := NewEscrow([]byte("escrow"), a.Address(), b.Address(), c,
+		mustCombineCoins(x.NewCoin(1, 0, "FOO")), Timeout, "")
+	require.NoError(t, old.Save(db, esc))
+
+	bucket := NewBucket()
+	assert.Error(t, bucket.Delete(db, esc.Key()))
+
+	_, err := orm.Migrations(Migrations()).Tick(context.Background(), db)
+	require.NoError(t, err)
+	objs, err := bucket.GetIndexed(db, "sender_recipient", orm.CompoundKey(a.Address(), b.Address()))
+	require.NoError(t, err)
+	assert.Len(t, objs, 1)
+	objs, err = bucket.GetIndexed(db, "ref_arbiter_contract", contract.Key())
+	require.NoError(t, err)
+	assert.Len(t, objs, 1)
+	n, err := bucket.Count(db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	require.NoError(t, bucket.Delete(db, esc.Key()))
+	n, err = bucket.Count(db, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestArbiterContract(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+	_, b := helpers.MakeKey()
+
+	db := store.MemStore()
+	contracts := multisig.NewContractBucket()
+	contract := contracts.Build(db, &multisig.Contract{
+		Sigs:                [][]byte{a.Address()},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+	})
+	c := multisig.MultiSigCondition(contract.Key())
+	esc := NewEscrow([]byte("escrow"), a.Address(), b.Address(), c,
+		mustCombineCoins(x.NewCoin(1, 0, "FOO")), Timeout, "")
+
+	// the contract is not stored yet
+	bucket := NewBucket()
+	err := bucket.Save(db, esc)
+	assert.True(t, orm.IsDanglingReferenceErr(err), "%+v", err)
+
+	require.NoError(t, contracts.SaveAt(db, 0, contract))
+	require.NoError(t, bucket.Save(db, esc))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/multisig"
 )
 
 const (
@@ -36,7 +37,7 @@ func (e *Escrow) Validate() error {
 	if len(e.Memo) > maxMemoSize {
 		return ErrInvalidMemo(e.Memo)
 	}
-	if err := validateAmount(e.Amount); err != nil {
+	if err := validateAmounts(e.Amount, e.PreciseAmount); err != nil {
 		return err
 	}
 	if err := validateConditions(e.Arbiter); err != nil {
@@ -54,9 +55,17 @@ func (e *Escrow) Copy() orm.CloneableData {
 		Amount:    e.Amount,
 		Timeout:   e.Timeout,
 		Memo:      e.Memo,
+
+		PreciseAmount: e.PreciseAmount,
 	}
 }
 
+// Balance returns all tokens held by the escrow,
+// whether stored as coins or precise amounts
+func (e *Escrow) Balance() (x.Amounts, error) {
+	return combineAmounts(e.Amount, e.PreciseAmount)
+}
+
 // AsEscrow extracts an *Escrow value or nil from the object
 // Must be called on a Bucket result that is an *Escrow,
 // will panic on bad type.
@@ -114,7 +123,17 @@ func NewBucket() Bucket {
 		orm.NewSimpleObj(nil, new(Escrow))).
 		WithIndex("sender", idxSender, false).
 		WithIndex("recipient", idxRecipient, false).
-		WithIndex("arbiter", idxArbiter, false)
+		WithIndex("arbiter", idxArbiter, false).
+		WithCompoundIndex("sender_recipient", idxSenderRecipient, false).
+		// a multisig arbiter must point to a stored contract.
+		// Contracts cannot be deleted, so no OnDelete policy
+		// applies and the contract bucket has no referrer.
+		WithReference(orm.Reference{
+			Name:   "arbiter_contract",
+			Target: multisig.BucketName,
+			Keys:   refArbiterContract,
+		}).
+		WithCounter()
 
 	return Bucket{
 		Bucket: bucket,
@@ -123,6 +142,18 @@ func NewBucket() Bucket {
 	// TODO: add indexes
 }
 
+// Migrations returns the state migrations required by this package,
+// an application using escrows must apply them, see orm.Migrations.
+func Migrations() []orm.Migration {
+	return []orm.Migration{
+		// the sender_recipient and ref_arbiter_contract indexes
+		// were added when escrows could already exist
+		orm.IndexMigration("escrow_indexes", NewBucket().Bucket),
+		// so was the counter
+		orm.CounterMigration("escrow_counter", NewBucket().Bucket),
+	}
+}
+
 func getEscrow(obj orm.Object) (*Escrow, error) {
 	if obj == nil {
 		return nil, errors.New("Cannot take index of nil")
@@ -158,6 +189,30 @@ func idxArbiter(obj orm.Object) ([]byte, error) {
 	return esc.Arbiter, nil
 }
 
+// idxSenderRecipient allows to query escrows by sender, or by
+// both sender and recipient, see orm.CompoundKey
+func idxSenderRecipient(obj orm.Object) ([][]byte, error) {
+	esc, err := getEscrow(obj)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{esc.Sender, esc.Recipient}, nil
+}
+
+// refArbiterContract references the multisig contract used as
+// the arbiter, if any, so it must exist when the escrow is saved
+func refArbiterContract(obj orm.Object) ([][]byte, error) {
+	esc, err := getEscrow(obj)
+	if err != nil {
+		return nil, err
+	}
+	id := multisig.ContractID(esc.Arbiter)
+	if id == nil {
+		return nil, nil
+	}
+	return [][]byte{id}, nil
+}
+
 // Build assigns an ID to given escrow instance and returns it as an orm
 // Object. It does not persist the escrow in the store.
 func (b Bucket) Build(db weave.KVStore, escrow *Escrow) orm.Object {
@@ -2,6 +2,7 @@ package escrow
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/cash"
 )
@@ -57,27 +58,28 @@ func NewCreateMsg(send, rcpt weave.Address, arb weave.Condition,
 	}
 }
 
-// Validate makes sure that this is sensible
+// Validate makes sure that this is sensible,
+// reporting all invalid fields at once
 func (m *CreateEscrowMsg) Validate() error {
+	var errs error
 	if m.Arbiter == nil {
-		return ErrMissingArbiter()
+		errs = errors.Append(errs, errors.Field("arbiter", ErrMissingArbiter()))
 	}
 	if m.Recipient == nil {
-		return ErrMissingRecipient()
+		errs = errors.Append(errs, errors.Field("recipient", ErrMissingRecipient()))
 	}
 	if m.Timeout <= 0 {
-		return ErrInvalidTimeout(m.Timeout)
+		errs = errors.Append(errs, errors.Field("timeout", ErrInvalidTimeout(m.Timeout)))
 	}
 	if len(m.Memo) > maxMemoSize {
-		return ErrInvalidMemo(m.Memo)
-	}
-	if err := validateAmount(m.Amount); err != nil {
-		return err
-	}
-	if err := validateConditions(m.Arbiter); err != nil {
-		return err
-	}
-	return validateAddresses(m.Src, m.Recipient)
+		errs = errors.Append(errs, errors.Field("memo", ErrInvalidMemo(m.Memo)))
+	}
+	return errors.Append(errs,
+		errors.Field("amount", validateAmounts(m.Amount, m.PreciseAmount)),
+		errors.Field("arbiter", validateConditions(m.Arbiter)),
+		errors.Field("src", validateAddresses(m.Src)),
+		errors.Field("recipient", validateAddresses(m.Recipient)),
+	)
 }
 
 // Validate makes sure that this is sensible
@@ -86,10 +88,22 @@ func (m *ReleaseEscrowMsg) Validate() error {
 	if err != nil {
 		return err
 	}
-	if m.Amount == nil {
+	if m.Amount == nil && m.PreciseAmount == nil {
 		return nil
 	}
-	return validateAmount(m.Amount)
+	return validateAmounts(m.Amount, m.PreciseAmount)
+}
+
+// Amounts returns all tokens to deposit, coins and
+// precise amounts combined
+func (m *CreateEscrowMsg) Amounts() (x.Amounts, error) {
+	return combineAmounts(m.Amount, m.PreciseAmount)
+}
+
+// Amounts returns all tokens to release, coins and
+// precise amounts combined. Empty means the whole escrow.
+func (m *ReleaseEscrowMsg) Amounts() (x.Amounts, error) {
+	return combineAmounts(m.Amount, m.PreciseAmount)
 }
 
 // Validate always returns true for no data
@@ -142,14 +156,32 @@ func validateAddresses(addrs ...weave.Address) error {
 	return nil
 }
 
-func validateAmount(amount x.Coins) error {
-	// we enforce this is positive
-	positive := amount.IsPositive()
-	if !positive {
+func validateAmounts(amount x.Coins, precise x.Amounts) error {
+	if len(amount) == 0 && len(precise) == 0 {
 		return cash.ErrInvalidAmount("Non-positive SendMsg")
 	}
-	// then make sure these are properly formatted coins
-	return amount.Validate()
+	if len(amount) != 0 {
+		// we enforce this is positive
+		if !amount.IsPositive() {
+			return cash.ErrInvalidAmount("Non-positive SendMsg")
+		}
+		// then make sure these are properly formatted coins
+		if err := amount.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(precise) != 0 {
+		if !precise.IsPositive() {
+			return cash.ErrInvalidAmount("Non-positive SendMsg")
+		}
+		return precise.Validate()
+	}
+	return nil
+}
+
+// combineAmounts returns coins and precise amounts as one set
+func combineAmounts(amount x.Coins, precise x.Amounts) (x.Amounts, error) {
+	return amount.Amounts().Combine(precise)
 }
 
 func validateEscrowID(id []byte) error {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/stretchr/testify/assert"
@@ -140,6 +141,30 @@ func TestCreateEscrowMsg(t *testing.T) {
 	}
 }
 
+func TestCreateEscrowMsgAllErrors(t *testing.T) {
+	var helpers x.TestHelpers
+	_, a := helpers.MakeKey()
+
+	msg := &CreateEscrowMsg{
+		Arbiter: a,
+		Amount:  mustCombineCoins(x.NewCoin(100, 0, "FOO")),
+		Timeout: -1,
+		Memo:    strings.Repeat("foo", 100),
+	}
+	err := msg.Validate()
+	// the first problem is still the cause
+	assert.True(t, IsMissingConditionErr(err), "%+v", err)
+
+	multi, ok := err.(*errors.MultiError)
+	if assert.True(t, ok, "%+v", err) {
+		assert.Len(t, multi.Errors(), 3)
+	}
+	log := err.(errors.TMError).ABCILog()
+	for _, field := range []string{"recipient: ", "timeout: ", "memo: "} {
+		assert.Contains(t, log, field)
+	}
+}
+
 func TestReleaseEscrowMsg(t *testing.T) {
 	// valid: fixed 8 byte id
 	escrow := []byte{1, 2, 3, 4, 5, 6, 7, 8}
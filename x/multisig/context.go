@@ -29,6 +29,16 @@ func MultiSigCondition(id []byte) weave.Condition {
 	return weave.NewCondition("multisig", "usage", id)
 }
 
+// ContractID returns the ID of the contract if the condition
+// was created by MultiSigCondition, or nil
+func ContractID(cond weave.Condition) []byte {
+	ext, typ, id, err := cond.Parse()
+	if err != nil || ext != "multisig" || typ != "usage" {
+		return nil
+	}
+	return id
+}
+
 // Authenticate gets/sets permissions on the given context key
 type Authenticate struct {
 }
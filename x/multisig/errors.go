@@ -14,33 +14,30 @@ const (
 )
 
 var (
-	errMissingSigs      = fmt.Errorf("Missing sigs")
-	errInvalidThreshold = fmt.Errorf("Activation threshold must be lower than or equal to the number of sigs")
+	codes = errors.RegisterRange("multisig", 1030, 1040)
 
-	errUnauthorizedMultiSig = fmt.Errorf("Multisig authentication failed")
-	errContractNotFound     = fmt.Errorf("Multisig contract not found")
+	InvalidMsgErr             = codes.Register(CodeInvalidMsg, "invalid message")
+	MultisigAuthenticationErr = codes.Register(CodeMultisigAuthentication, "multisig authentication")
 )
 
 func ErrMissingSigs() error {
-	return errors.WithCode(errMissingSigs, CodeInvalidMsg)
+	return InvalidMsgErr.New("missing sigs")
 }
 func ErrInvalidActivationThreshold() error {
-	return errors.WithCode(errInvalidThreshold, CodeInvalidMsg)
+	return InvalidMsgErr.New("activation threshold must be lower than or equal to the number of sigs")
 }
 func ErrInvalidChangeThreshold() error {
-	return errors.WithCode(errInvalidThreshold, CodeInvalidMsg)
+	return InvalidMsgErr.New("invalid admin threshold")
 }
 func IsInvalidMsgErr(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidMsg)
 }
 
 func ErrUnauthorizedMultiSig(contract []byte) error {
-	msg := fmt.Sprintf("contract=%X", contract)
-	return errors.WithLog(msg, errUnauthorizedMultiSig, CodeMultisigAuthentication)
+	return MultisigAuthenticationErr.New(fmt.Sprintf("contract=%X", contract))
 }
 func ErrContractNotFound(contract []byte) error {
-	msg := fmt.Sprintf("contract=%X", contract)
-	return errors.WithLog(msg, errContractNotFound, CodeMultisigAuthentication)
+	return MultisigAuthenticationErr.New(fmt.Sprintf("contract not found: contract=%X", contract))
 }
 func IsMultiSigAuthenticationErr(err error) bool {
 	return errors.HasErrorCode(err, CodeMultisigAuthentication)
@@ -52,7 +52,8 @@ func (h CreateContractMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, t
 	}
 
 	obj := h.bucket.Build(db, contract)
-	if err = h.bucket.Save(db, obj); err != nil {
+	height, _ := weave.GetHeight(ctx)
+	if err = h.bucket.SaveAt(db, height, obj); err != nil {
 		return res, err
 	}
 	res.Data = obj.Key()
@@ -117,7 +118,8 @@ func (h UpdateContractMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, t
 	}
 
 	obj := orm.NewSimpleObj(msg.Id, contract)
-	err = h.bucket.Save(db, obj)
+	height, _ := weave.GetHeight(ctx)
+	err = h.bucket.SaveAt(db, height, obj)
 	if err != nil {
 		return res, err
 	}
@@ -112,7 +112,7 @@ func TestCreateContractMsgHandler(t *testing.T) {
 				ActivationThreshold: 0,
 				AdminThreshold:      1,
 			},
-			err: ErrInvalidChangeThreshold(),
+			err: ErrInvalidActivationThreshold(),
 		},
 	}
 
@@ -240,6 +240,12 @@ func TestUpdateContractMsgHandler(t *testing.T) {
 				Contract{msg.Sigs, msg.ActivationThreshold, msg.AdminThreshold},
 				contract,
 				test.name)
+
+			// the latest version is recorded in the history
+			versions, err := handler.bucket.History(db, msg.Id)
+			require.NoError(t, err, test.name)
+			require.NotEmpty(t, versions, test.name)
+			require.EqualValues(t, &contract, versions[len(versions)-1].Object.Value(), test.name)
 		} else {
 			require.EqualError(t, err, test.err.Error(), test.name)
 		}
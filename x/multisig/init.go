@@ -32,7 +32,8 @@ func (*Initializer) FromGenesis(opts weave.Options, db weave.KVStore) error {
 			AdminThreshold:      c.AdminThreshold,
 		}
 		obj := bucket.Build(db, &contract)
-		if err := bucket.Save(db, obj); err != nil {
+		// genesis is the state before the first block
+		if err := bucket.SaveAt(db, 0, obj); err != nil {
 			return err
 		}
 	}
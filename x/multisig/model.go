@@ -43,33 +43,26 @@ func (c *Contract) Copy() orm.CloneableData {
 	}
 }
 
-// ContractBucket is a type-safe wrapper around orm.Bucket
+// ContractBucket is a type-safe wrapper around orm.VersionedBucket,
+// so the history of every contract is kept
 type ContractBucket struct {
-	orm.Bucket
+	orm.VersionedBucket
 	idSeq orm.Sequence
 }
 
 // NewContractBucket initializes a ContractBucket with default name
 //
-// inherit Get and Save from orm.Bucket
-// add run-time check on Save
+// inherit Get, Save and SaveAt from orm.VersionedBucket,
+// which only accepts Contract objects
 func NewContractBucket() ContractBucket {
 	bucket := orm.NewBucket(BucketName,
 		orm.NewSimpleObj(nil, new(Contract)))
 	return ContractBucket{
-		Bucket: bucket,
-		idSeq:  bucket.Sequence(SequenceName),
+		VersionedBucket: bucket.WithHistory(),
+		idSeq:           bucket.Sequence(SequenceName),
 	}
 }
 
-// Save enforces the proper type
-func (b ContractBucket) Save(db weave.KVStore, obj orm.Object) error {
-	if _, ok := obj.Value().(*Contract); !ok {
-		return orm.ErrInvalidObject(obj.Value())
-	}
-	return b.Bucket.Save(db, obj)
-}
-
 // Build assigns an ID to given contract instance and returns it as an orm
 // Object. It does not persist the escrow in the store.
 func (b ContractBucket) Build(db weave.KVStore, c *Contract) orm.Object {
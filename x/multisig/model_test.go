@@ -0,0 +1,24 @@
+package multisig
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+)
+
+func TestContractBucketType(t *testing.T) {
+	db := store.WithHeight(store.MemStore(), 5)
+	bucket := NewContractBucket()
+
+	obj := orm.NewSimpleObj([]byte("counter"), orm.NewCounter(1))
+	if err := bucket.Save(db, obj); !orm.IsInvalidObjectErr(err) {
+		t.Fatalf("want invalid object error, got %v", err)
+	}
+	if err := bucket.SaveAt(db, 5, obj); !orm.IsInvalidObjectErr(err) {
+		t.Fatalf("want invalid object error, got %v", err)
+	}
+	if versions, err := bucket.History(db, []byte("counter")); err != nil || len(versions) != 0 {
+		t.Fatalf("want no history, got %v, %v", versions, err)
+	}
+}
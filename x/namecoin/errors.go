@@ -7,7 +7,7 @@ import (
 )
 
 // ABCI Response Codes
-// namecoin takes 1000-1010
+// namecoin takes 1000-1009
 const (
 	CodeInvalidToken  = 1000
 	CodeInvalidIndex  = 1001
@@ -15,45 +15,41 @@ const (
 )
 
 var (
-	errInvalidTokenName  = fmt.Errorf("Invalid token name")
-	errDuplicateToken    = fmt.Errorf("Token with that ticker already exists")
-	errInvalidSigFigs    = fmt.Errorf("Invalid significant figures")
-	errInvalidIndex      = fmt.Errorf("Cannot calculate index")
-	errInvalidWalletName = fmt.Errorf("Invalid name for a wallet")
-	errChangeWalletName  = fmt.Errorf("Wallet already has a name")
-	errNoSuchWallet      = fmt.Errorf("No wallet exists with this address")
+	codes = errors.RegisterRange("namecoin", 1000, 1009)
+
+	InvalidTokenErr  = codes.Register(CodeInvalidToken, "invalid token")
+	InvalidIndexErr  = codes.Register(CodeInvalidIndex, "invalid index")
+	InvalidWalletErr = codes.Register(CodeInvalidWallet, "invalid wallet")
 )
 
 func ErrInvalidTokenName(name string) error {
-	return errors.WithLog(name, errInvalidTokenName, CodeInvalidToken)
+	return InvalidTokenErr.New("invalid token name: " + name)
 }
 func ErrDuplicateToken(name string) error {
-	return errors.WithLog(name, errDuplicateToken, CodeInvalidToken)
+	return InvalidTokenErr.New("token with that ticker already exists: " + name)
 }
 func ErrInvalidSigFigs(figs int32) error {
-	msg := fmt.Sprintf("%d", figs)
-	return errors.WithLog(msg, errInvalidSigFigs, CodeInvalidToken)
+	return InvalidTokenErr.New(fmt.Sprintf("invalid significant figures: %d", figs))
 }
 func IsInvalidToken(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidToken)
 }
 
 func ErrInvalidIndex(reason string) error {
-	return errors.WithLog(reason, errInvalidIndex, CodeInvalidIndex)
+	return InvalidIndexErr.New(reason)
 }
 func IsInvalidIndex(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidIndex)
 }
 
 func ErrChangeWalletName() error {
-	return errors.WithCode(errChangeWalletName, CodeInvalidWallet)
+	return InvalidWalletErr.New("wallet already has a name")
 }
 func ErrInvalidWalletName(name string) error {
-	return errors.WithLog(name, errInvalidWalletName, CodeInvalidWallet)
+	return InvalidWalletErr.New("invalid name for a wallet: " + name)
 }
 func ErrNoSuchWallet(addr []byte) error {
-	name := fmt.Sprintf("%X", addr)
-	return errors.WithLog(name, errNoSuchWallet, CodeInvalidWallet)
+	return InvalidWalletErr.New(fmt.Sprintf("no wallet exists with this address: %X", addr))
 }
 func IsInvalidWallet(err error) bool {
 	return errors.HasErrorCode(err, CodeInvalidWallet)
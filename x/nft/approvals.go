@@ -44,6 +44,9 @@ func (m Approval) Validate() error {
 	return m.Options.Validate()
 }
 
+// AsAddress returns the approved address. It is the hash of a
+// condition, not the key of a stored object, so approvals cannot be
+// declared as orm references.
 func (a Approval) AsAddress() weave.Address {
 	return weave.Address(a.Address)
 }
@@ -2,7 +2,6 @@ package nft
 
 import (
 	"encoding/hex"
-	stderrors "errors"
 
 	"github.com/iov-one/weave/errors"
 )
@@ -24,57 +23,59 @@ const (
 )
 
 var (
-	errUnsupportedTokenType = stderrors.New("Unsupported token type")
-	errInvalidID            = stderrors.New("ID is invalid")
-	errDuplicateEntry       = stderrors.New("Duplicate entry")
-	errMissingEntry         = stderrors.New("Missing entry")
-	errInvalidEntry         = stderrors.New("Invalid entry")
-	errUnknownID            = stderrors.New("Unknown ID")
-	errInvalidLength        = stderrors.New("Invalid length")
-	errInvalidHost          = stderrors.New("Invalid host")
-	errInvalidPort          = stderrors.New("Invalid port")
-	errInvalidProtocol      = stderrors.New("Invalid protocol")
-	errInvalidCodec         = stderrors.New("Invalid codec")
-	errInvalidJson          = stderrors.New("Invalid json")
+	codes = errors.RegisterRange("nft", 500, 600)
+
+	UnsupportedTokenTypeErr = codes.Register(CodeUnsupportedTokenType, "unsupported token type")
+	InvalidIDErr            = codes.Register(CodeInvalidID, "invalid id")
+	DuplicateEntryErr       = codes.Register(CodeDuplicateEntry, "duplicate entry")
+	MissingEntryErr         = codes.Register(CodeMissingEntry, "missing entry")
+	InvalidEntryErr         = codes.Register(CodeInvalidEntry, "invalid entry")
+	UnknownIDErr            = codes.Register(CodeUnknownID, "unknown id")
+	InvalidLengthErr        = codes.Register(CodeInvalidLength, "invalid length")
+	InvalidHostErr          = codes.Register(CodeInvalidHost, "invalid host")
+	InvalidPortErr          = codes.Register(CodeInvalidPort, "invalid port")
+	InvalidProtocolErr      = codes.Register(CodeInvalidProtocol, "invalid protocol")
+	InvalidCodecErr         = codes.Register(CodeInvalidCodec, "invalid codec")
+	InvalidJsonErr          = codes.Register(CodeInvalidJson, "invalid json")
 )
 
 // ErrUnsupportedTokenType is when the type passed does not match the expected token type.
 func ErrUnsupportedTokenType() error {
-	return errors.WithCode(errUnsupportedTokenType, CodeUnsupportedTokenType)
+	return UnsupportedTokenTypeErr
 }
 
 func ErrInvalidID(id []byte) error {
-	return errors.WithLog(printableID(id), errInvalidID, CodeInvalidID)
+	return InvalidIDErr.New(printableID(id))
 }
 func ErrDuplicateEntry(id []byte) error {
-	return errors.WithLog(printableID(id), errDuplicateEntry, CodeDuplicateEntry)
+	return DuplicateEntryErr.New(printableID(id))
 }
 func ErrMissingEntry() error {
-	return errors.WithCode(errMissingEntry, CodeMissingEntry)
+	return MissingEntryErr
 }
 func ErrInvalidEntry(id []byte) error {
-	return errors.WithLog(printableID(id), errInvalidEntry, CodeInvalidEntry)
+	return InvalidEntryErr.New(printableID(id))
 }
 func ErrUnknownID(id []byte) error {
-	return errors.WithLog(printableID(id), errUnknownID, CodeUnknownID)
+	return UnknownIDErr.New(printableID(id))
 }
 func ErrInvalidLength() error {
-	return errors.WithCode(errInvalidLength, CodeInvalidLength)
+	return InvalidLengthErr
 }
 func ErrInvalidHost() error {
-	return errors.WithCode(errInvalidHost, CodeInvalidHost)
+	return InvalidHostErr
 }
 func ErrInvalidPort() error {
-	return errors.WithCode(errInvalidPort, CodeInvalidPort)
+	return InvalidPortErr
 }
 func ErrInvalidProtocol() error {
-	return errors.WithCode(errInvalidProtocol, CodeInvalidProtocol)
+	return InvalidProtocolErr
 }
 func ErrInvalidCodec(codec string) error {
-	return errors.WithLog(codec, errInvalidCodec, CodeInvalidCodec)
+	return InvalidCodecErr.New(codec)
 }
 func ErrInvalidJson() error {
-	return errors.WithCode(errInvalidJson, CodeInvalidJson)
+	return InvalidJsonErr
 }
 
 // id's are stored as bytes, but most are ascii text
@@ -58,6 +58,10 @@ type PaymentChannel struct {
 	// Transferred represents total amount that was transferred using allocated
 	// (total) value. Transferred must never exceed total value.
 	Transferred *x.Coin `protobuf:"bytes,7,opt,name=transferred" json:"transferred,omitempty"`
+	// Precise total and precise transferred replace total and transferred
+	// for channels of tokens that cannot be represented by a Coin.
+	PreciseTotal       *x.Amount `protobuf:"bytes,8,opt,name=precise_total,json=preciseTotal" json:"precise_total,omitempty"`
+	PreciseTransferred *x.Amount `protobuf:"bytes,9,opt,name=precise_transferred,json=preciseTransferred" json:"precise_transferred,omitempty"`
 }
 
 func (m *PaymentChannel) Reset()                    { *m = PaymentChannel{} }
@@ -114,6 +118,20 @@ func (m *PaymentChannel) GetTransferred() *x.Coin {
 	return nil
 }
 
+func (m *PaymentChannel) GetPreciseTotal() *x.Amount {
+	if m != nil {
+		return m.PreciseTotal
+	}
+	return nil
+}
+
+func (m *PaymentChannel) GetPreciseTransferred() *x.Amount {
+	if m != nil {
+		return m.PreciseTransferred
+	}
+	return nil
+}
+
 // CreatePaymentChannelMsg creates a new payment channel that can be used to
 // transfer value between two parties.
 //
@@ -133,6 +151,9 @@ type CreatePaymentChannelMsg struct {
 	Timeout int64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	// Max length 128 character.
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Precise total can be set instead of total, for tokens that cannot be
+	// represented by a Coin. The channel then uses precise amounts only.
+	PreciseTotal *x.Amount `protobuf:"bytes,7,opt,name=precise_total,json=preciseTotal" json:"precise_total,omitempty"`
 }
 
 func (m *CreatePaymentChannelMsg) Reset()                    { *m = CreatePaymentChannelMsg{} }
@@ -182,6 +203,13 @@ func (m *CreatePaymentChannelMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreatePaymentChannelMsg) GetPreciseTotal() *x.Amount {
+	if m != nil {
+		return m.PreciseTotal
+	}
+	return nil
+}
+
 // Payment is created by the sender. Sender should give the message to the
 // recipient, so that it can be redeemed at any time.
 //
@@ -192,6 +220,8 @@ type Payment struct {
 	Amount    *x.Coin `protobuf:"bytes,3,opt,name=amount" json:"amount,omitempty"`
 	// Max length 128 character.
 	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Precise amount can be set instead of amount.
+	PreciseAmount *x.Amount `protobuf:"bytes,5,opt,name=precise_amount,json=preciseAmount" json:"precise_amount,omitempty"`
 }
 
 func (m *Payment) Reset()                    { *m = Payment{} }
@@ -227,6 +257,13 @@ func (m *Payment) GetMemo() string {
 	return ""
 }
 
+func (m *Payment) GetPreciseAmount() *x.Amount {
+	if m != nil {
+		return m.PreciseAmount
+	}
+	return nil
+}
+
 // TransferPaymentChannelMsg binds Payment with a signature created using
 // senders private key.
 // Signature is there to ensure that payment message was not altered.
@@ -360,6 +397,26 @@ func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n3
 	}
+	if m.PreciseTotal != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PreciseTotal.Size()))
+		n4, err := m.PreciseTotal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.PreciseTransferred != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PreciseTransferred.Size()))
+		n5, err := m.PreciseTransferred.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -388,11 +445,11 @@ func (m *CreatePaymentChannelMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SenderPubkey.Size()))
-		n4, err := m.SenderPubkey.MarshalTo(dAtA[i:])
+		n6, err := m.SenderPubkey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if len(m.Recipient) > 0 {
 		dAtA[i] = 0x1a
@@ -404,11 +461,11 @@ func (m *CreatePaymentChannelMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
-		n5, err := m.Total.MarshalTo(dAtA[i:])
+		n7, err := m.Total.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if m.Timeout != 0 {
 		dAtA[i] = 0x28
@@ -421,6 +478,16 @@ func (m *CreatePaymentChannelMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if m.PreciseTotal != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PreciseTotal.Size()))
+		n8, err := m.PreciseTotal.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -455,11 +522,11 @@ func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-		n6, err := m.Amount.MarshalTo(dAtA[i:])
+		n9, err := m.Amount.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n9
 	}
 	if len(m.Memo) > 0 {
 		dAtA[i] = 0x22
@@ -467,6 +534,16 @@ func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if m.PreciseAmount != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PreciseAmount.Size()))
+		n10, err := m.PreciseAmount.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	return i, nil
 }
 
@@ -489,21 +566,21 @@ func (m *TransferPaymentChannelMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Payment.Size()))
-		n7, err := m.Payment.MarshalTo(dAtA[i:])
+		n11, err := m.Payment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n11
 	}
 	if m.Signature != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
-		n8, err := m.Signature.MarshalTo(dAtA[i:])
+		n12, err := m.Signature.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	return i, nil
 }
@@ -577,6 +654,14 @@ func (m *PaymentChannel) Size() (n int) {
 		l = m.Transferred.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.PreciseTotal != nil {
+		l = m.PreciseTotal.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.PreciseTransferred != nil {
+		l = m.PreciseTransferred.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -606,6 +691,10 @@ func (m *CreatePaymentChannelMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.PreciseTotal != nil {
+		l = m.PreciseTotal.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -628,6 +717,10 @@ func (m *Payment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.PreciseAmount != nil {
+		l = m.PreciseAmount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -910,6 +1003,72 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreciseTotal == nil {
+				m.PreciseTotal = &x.Amount{}
+			}
+			if err := m.PreciseTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseTransferred", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreciseTransferred == nil {
+				m.PreciseTransferred = &x.Amount{}
+			}
+			if err := m.PreciseTransferred.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1136,6 +1295,39 @@ func (m *CreatePaymentChannelMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreciseTotal == nil {
+				m.PreciseTotal = &x.Amount{}
+			}
+			if err := m.PreciseTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1308,6 +1500,39 @@ func (m *Payment) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreciseAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreciseAmount == nil {
+				m.PreciseAmount = &x.Amount{}
+			}
+			if err := m.PreciseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1663,35 +1888,39 @@ var (
 func init() { proto.RegisterFile("x/paychan/codec.proto", fileDescriptorCodec) }
 
 var fileDescriptorCodec = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xd9, 0x26, 0x8d, 0xf1, 0x26, 0x45, 0x61, 0x25, 0xc0, 0x54, 0x90, 0x44, 0x39, 0x54,
-	0x01, 0xb5, 0xb6, 0x54, 0x24, 0x1e, 0x20, 0xe9, 0x25, 0x42, 0x48, 0x91, 0xe1, 0xc4, 0xa5, 0xda,
-	0xac, 0xa7, 0xc9, 0x0a, 0x7b, 0xd7, 0x5a, 0xaf, 0x4b, 0xfc, 0x16, 0x1c, 0x78, 0x28, 0x4e, 0x88,
-	0x27, 0xa8, 0x90, 0x79, 0x01, 0x1e, 0x01, 0x79, 0xbd, 0x21, 0x0e, 0x7f, 0xc4, 0xb9, 0xb7, 0x99,
-	0x6f, 0x3e, 0x8f, 0xe7, 0x37, 0xab, 0xc1, 0x0f, 0x36, 0x41, 0x4a, 0x0b, 0xb6, 0xa6, 0x22, 0x60,
-	0x32, 0x02, 0xe6, 0xa7, 0x4a, 0x6a, 0x49, 0x1c, 0x2b, 0x1e, 0x9f, 0xad, 0xb8, 0x5e, 0xe7, 0x4b,
-	0x9f, 0xc9, 0x24, 0x58, 0xc9, 0x95, 0x0c, 0x4c, 0x7d, 0x99, 0x5f, 0x99, 0xcc, 0x24, 0x26, 0xaa,
-	0xbf, 0x3b, 0x3e, 0x6d, 0xd8, 0xb9, 0xbc, 0x3e, 0x93, 0x02, 0x82, 0x0f, 0x40, 0xaf, 0x21, 0x60,
-	0xaa, 0x48, 0xb5, 0x0c, 0x12, 0x19, 0x41, 0x9c, 0x59, 0xf7, 0xc9, 0x3f, 0xdd, 0x9b, 0xe6, 0x34,
-	0xe3, 0x1f, 0x08, 0xdf, 0x5b, 0xd0, 0x22, 0x01, 0xa1, 0x67, 0x6b, 0x2a, 0x04, 0xc4, 0xa4, 0x8f,
-	0x5b, 0x99, 0x62, 0x1e, 0x1a, 0xa1, 0x49, 0x2f, 0xac, 0x42, 0xf2, 0x12, 0x1f, 0x65, 0x20, 0x22,
-	0x50, 0x97, 0x69, 0xbe, 0x7c, 0x0f, 0x85, 0x77, 0x30, 0x42, 0x93, 0xee, 0xf9, 0x7d, 0xbf, 0xfe,
-	0xb3, 0xbf, 0xc8, 0x97, 0x31, 0x67, 0xaf, 0xa0, 0x08, 0x7b, 0xb5, 0x6f, 0x61, 0x6c, 0xe4, 0x09,
-	0x76, 0x15, 0x30, 0x9e, 0x72, 0x10, 0xda, 0x6b, 0x99, 0x7e, 0x3b, 0x81, 0x3c, 0xc5, 0x87, 0x5a,
-	0x6a, 0x1a, 0x7b, 0x6d, 0xd3, 0xcd, 0xf1, 0x37, 0xfe, 0x4c, 0x72, 0x11, 0xd6, 0x2a, 0xf1, 0xb0,
-	0xa3, 0x79, 0x02, 0x32, 0xd7, 0xde, 0xe1, 0x08, 0x4d, 0x5a, 0xe1, 0x36, 0x25, 0x04, 0xb7, 0x13,
-	0x48, 0xa4, 0xd7, 0x19, 0xa1, 0x89, 0x1b, 0x9a, 0x98, 0x3c, 0xc3, 0x5d, 0xad, 0xa8, 0xc8, 0xae,
-	0x40, 0x29, 0x88, 0x3c, 0x67, 0xbf, 0x65, 0xb3, 0x36, 0xfe, 0x82, 0xf0, 0xa3, 0x99, 0x02, 0xaa,
-	0x61, 0x1f, 0xfc, 0x75, 0xb6, 0xba, 0x95, 0xec, 0xe3, 0x4f, 0x08, 0x3b, 0x16, 0x85, 0x9c, 0xe0,
-	0xbb, 0x6c, 0x4d, 0xb9, 0xb8, 0xe4, 0x91, 0xa1, 0x70, 0xa7, 0xdd, 0xf2, 0x66, 0xe8, 0xcc, 0x2a,
-	0x6d, 0x7e, 0x11, 0x3a, 0xa6, 0x38, 0x8f, 0xc8, 0x29, 0xc6, 0xac, 0xc6, 0xae, 0x9c, 0x15, 0x53,
-	0x6f, 0x7a, 0x54, 0xde, 0x0c, 0x5d, 0xbb, 0x8c, 0xf9, 0x45, 0xe8, 0x5a, 0xc3, 0x3c, 0x22, 0x43,
-	0xdc, 0xa1, 0x89, 0xcc, 0x2d, 0x49, 0x63, 0x5e, 0x2b, 0xff, 0x1a, 0xab, 0xdd, 0x18, 0x6b, 0x83,
-	0x1f, 0xbf, 0xb5, 0x6b, 0xff, 0x73, 0xd1, 0xcf, 0x71, 0x75, 0x07, 0x95, 0x68, 0xc6, 0xec, 0x9e,
-	0xf7, 0x7d, 0x7b, 0x17, 0xbe, 0x35, 0x87, 0x5b, 0x03, 0x09, 0xb0, 0x9b, 0xf1, 0x95, 0xa0, 0x3a,
-	0x57, 0xf0, 0xfb, 0xfa, 0xdf, 0x6c, 0x0b, 0xe1, 0xce, 0x33, 0x7e, 0x87, 0x1f, 0xce, 0x62, 0x99,
-	0xfd, 0xe5, 0x7d, 0xf7, 0xb1, 0xd1, 0x7f, 0xb0, 0xb7, 0x54, 0x07, 0x3b, 0xaa, 0x69, 0xff, 0x73,
-	0x39, 0x40, 0x5f, 0xcb, 0x01, 0xfa, 0x56, 0x0e, 0xd0, 0xc7, 0xef, 0x83, 0x3b, 0xcb, 0x8e, 0xb9,
-	0xa4, 0x17, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xcc, 0x3a, 0xc7, 0xf0, 0x03, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xd9, 0x7c, 0xb9, 0x9e, 0x24, 0x55, 0x58, 0x04, 0x98, 0x0a, 0x92, 0x28, 0x87, 0x2a,
+	0xa0, 0x76, 0x8d, 0x8a, 0xc4, 0x81, 0x1b, 0x49, 0x2f, 0x11, 0x42, 0x8a, 0x0c, 0x27, 0x2e, 0xd1,
+	0xc6, 0x9e, 0x26, 0x16, 0xf1, 0xae, 0x65, 0xaf, 0x4b, 0xf2, 0x16, 0x3c, 0x15, 0xe2, 0xc8, 0x03,
+	0xa0, 0x0a, 0x85, 0xf7, 0x40, 0x28, 0x6b, 0xbb, 0x71, 0x52, 0x3e, 0xce, 0xbd, 0xed, 0xcc, 0xfc,
+	0x66, 0x67, 0xff, 0xff, 0x49, 0x0c, 0xf7, 0x97, 0x76, 0xc8, 0x57, 0xee, 0x9c, 0x0b, 0xdb, 0x95,
+	0x1e, 0xba, 0x2c, 0x8c, 0xa4, 0x92, 0xd4, 0xc8, 0x92, 0x47, 0xa7, 0x33, 0x5f, 0xcd, 0x93, 0x29,
+	0x73, 0x65, 0x60, 0xcf, 0xe4, 0x4c, 0xda, 0xba, 0x3e, 0x4d, 0x2e, 0x74, 0xa4, 0x03, 0x7d, 0x4a,
+	0xfb, 0x8e, 0x4e, 0x0a, 0xb8, 0x2f, 0x2f, 0x4f, 0xa5, 0x40, 0xfb, 0x13, 0xf2, 0x4b, 0xb4, 0xdd,
+	0x68, 0x15, 0x2a, 0x69, 0x07, 0xd2, 0xc3, 0x45, 0x9c, 0xd1, 0xc7, 0x7f, 0xa5, 0x97, 0xc5, 0xd7,
+	0xf4, 0xbe, 0x97, 0xe0, 0x70, 0xcc, 0x57, 0x01, 0x0a, 0x35, 0x9c, 0x73, 0x21, 0x70, 0x41, 0x5b,
+	0x50, 0x8e, 0x23, 0xd7, 0x22, 0x5d, 0xd2, 0x6f, 0x38, 0x9b, 0x23, 0x7d, 0x09, 0xcd, 0x18, 0x85,
+	0x87, 0xd1, 0x24, 0x4c, 0xa6, 0x1f, 0x71, 0x65, 0x95, 0xba, 0xa4, 0x5f, 0x3f, 0xbb, 0xcb, 0xd2,
+	0xc9, 0x6c, 0x9c, 0x4c, 0x17, 0xbe, 0xfb, 0x06, 0x57, 0x4e, 0x23, 0xe5, 0xc6, 0x1a, 0xa3, 0x8f,
+	0xc1, 0x8c, 0xd0, 0xf5, 0x43, 0x1f, 0x85, 0xb2, 0xca, 0xfa, 0xbe, 0x6d, 0x82, 0x3e, 0x81, 0xaa,
+	0x92, 0x8a, 0x2f, 0xac, 0x8a, 0xbe, 0xcd, 0x60, 0x4b, 0x36, 0x94, 0xbe, 0x70, 0xd2, 0x2c, 0xb5,
+	0xc0, 0x50, 0x7e, 0x80, 0x32, 0x51, 0x56, 0xb5, 0x4b, 0xfa, 0x65, 0x27, 0x0f, 0x29, 0x85, 0x4a,
+	0x80, 0x81, 0xb4, 0x6a, 0x5d, 0xd2, 0x37, 0x1d, 0x7d, 0xa6, 0x4f, 0xa1, 0xae, 0x22, 0x2e, 0xe2,
+	0x0b, 0x8c, 0x22, 0xf4, 0x2c, 0x63, 0xf7, 0xca, 0x62, 0x8d, 0x32, 0x68, 0x86, 0x9b, 0x57, 0xc4,
+	0x38, 0x49, 0xe7, 0x1f, 0x68, 0xd8, 0x64, 0x4b, 0xf6, 0x3a, 0x90, 0x89, 0x50, 0x4e, 0x23, 0xab,
+	0xbf, 0xd7, 0x0f, 0x79, 0x05, 0xf7, 0xae, 0xf9, 0xc2, 0x08, 0x73, 0xbf, 0x8b, 0xe6, 0x5d, 0x5b,
+	0xa8, 0xf7, 0x8b, 0xc0, 0xc3, 0x61, 0x84, 0x5c, 0xe1, 0xae, 0xc9, 0x6f, 0xe3, 0xd9, 0xed, 0xf4,
+	0xf9, 0x86, 0x79, 0xc6, 0x3f, 0xcd, 0xeb, 0x7d, 0x21, 0x60, 0x64, 0xd2, 0xe9, 0x31, 0x1c, 0xb8,
+	0x73, 0xee, 0x8b, 0x89, 0xef, 0x69, 0xd5, 0xe6, 0xa0, 0xbe, 0xbe, 0xea, 0x18, 0xc3, 0x4d, 0x6e,
+	0x74, 0xee, 0x18, 0xba, 0x38, 0xf2, 0xe8, 0x09, 0x80, 0x9b, 0xda, 0xb4, 0x21, 0x37, 0x1e, 0x34,
+	0x06, 0xcd, 0xf5, 0x55, 0xc7, 0xcc, 0xcc, 0x1b, 0x9d, 0x3b, 0x66, 0x06, 0x8c, 0x3c, 0xda, 0x81,
+	0x1a, 0xd7, 0x93, 0xad, 0xf2, 0xae, 0xbe, 0x2c, 0x7d, 0x2d, 0xa3, 0x52, 0x90, 0xf1, 0x1c, 0x0e,
+	0x73, 0x19, 0x59, 0x73, 0x75, 0x5f, 0x47, 0xae, 0x33, 0x0d, 0x7b, 0x4b, 0x78, 0x94, 0x2f, 0xf6,
+	0xe6, 0x2a, 0x9f, 0x81, 0x11, 0xa6, 0x49, 0x2d, 0xac, 0x7e, 0xd6, 0x62, 0xd9, 0xbf, 0x9c, 0x65,
+	0xb0, 0x93, 0x03, 0xd4, 0x06, 0x33, 0xf6, 0x67, 0x82, 0xab, 0x24, 0xc2, 0xfd, 0x05, 0xbf, 0xcb,
+	0x0b, 0xce, 0x96, 0xe9, 0x7d, 0x80, 0x07, 0xc3, 0x85, 0x8c, 0xff, 0xf0, 0x0b, 0xda, 0x35, 0x8a,
+	0xfc, 0xc7, 0xa8, 0xdc, 0x87, 0xd2, 0xd6, 0x87, 0x41, 0xeb, 0xeb, 0xba, 0x4d, 0xbe, 0xad, 0xdb,
+	0xe4, 0xc7, 0xba, 0x4d, 0x3e, 0xff, 0x6c, 0xdf, 0x99, 0xd6, 0xf4, 0x77, 0xe1, 0xc5, 0xef, 0x01,
+	0x00, 0x19, 0x68, 0x69, 0xb1, 0xbe, 0x04, 0x00, 0x00,
 }
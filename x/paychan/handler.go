@@ -77,15 +77,25 @@ func (h *createPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStor
 		return res, err
 	}
 
-	obj, err := h.bucket.Create(db, &PaymentChannel{
+	pc := &PaymentChannel{
 		Src:          msg.Src,
 		SenderPubkey: msg.SenderPubkey,
 		Recipient:    msg.Recipient,
 		Total:        msg.Total,
 		Timeout:      msg.Timeout,
 		Memo:         msg.Memo,
-		Transferred:  &x.Coin{Ticker: msg.Total.Ticker},
-	})
+		PreciseTotal: msg.PreciseTotal,
+	}
+	if msg.PreciseTotal != nil {
+		pc.PreciseTransferred = &x.Amount{
+			Decimals: msg.PreciseTotal.Decimals,
+			Ticker:   msg.PreciseTotal.Ticker,
+			Issuer:   msg.PreciseTotal.Issuer,
+		}
+	} else {
+		pc.Transferred = &x.Coin{Ticker: msg.Total.Ticker}
+	}
+	obj, err := h.bucket.Create(db, pc)
 	if err != nil {
 		return res, errors.Wrap(err, "cannot create a payment channel")
 	}
@@ -93,7 +103,7 @@ func (h *createPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStor
 	// Move coins from sender account and deposit total amount available on
 	// that channels account.
 	dst := paymentChannelAccount(obj.Key())
-	if err := h.cash.MoveCoins(db, msg.Src, dst, *msg.Total); err != nil {
+	if err := cash.MoveAmount(h.cash, db, msg.Src, dst, msg.TotalAmount()); err != nil {
 		return res, errors.Wrap(err, "cannot move coins")
 	}
 
@@ -150,17 +160,18 @@ func (h *transferPaymentChannelHandler) validate(ctx weave.Context, db weave.KVS
 		return msg, errors.InvalidMsgErr.New("invalid signature")
 	}
 
-	if !msg.Payment.Amount.SameType(*pc.Total) {
+	amount := msg.Payment.PaidAmount()
+	if !amount.SameType(pc.TotalAmount()) {
 		return msg, errors.InvalidMsgErr.New("amount and total amount use different ticker")
 	}
 
-	if msg.Payment.Amount.Compare(*pc.Total) > 0 {
+	if amount.Compare(pc.TotalAmount()) > 0 {
 		return msg, errors.InvalidMsgErr.New("amount greater than total amount")
 	}
 	// Payment is representing a cumulative amount that is to be
 	// transferred to recipients account. Because it is cumulative, every
 	// transfer request must be greater than the previous one.
-	if msg.Payment.Amount.Compare(*pc.Transferred) <= 0 {
+	if amount.Compare(pc.TransferredAmount()) <= 0 {
 		return msg, errors.InvalidMsgErr.New("amount must be greater than previously requested")
 	}
 
@@ -182,19 +193,21 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 	// Payment amount is total amount that should be transferred from
 	// payment channel to recipient. Deduct already transferred funds and
 	// move only the difference.
-	diff, err := msg.Payment.Amount.Subtract(*pc.Transferred)
+	diff, err := msg.Payment.PaidAmount().Subtract(pc.TransferredAmount())
 	if err != nil || diff.IsZero() {
 		return res, errors.InvalidMsgErr.New("invalid amount")
 	}
 
 	src := paymentChannelAccount(msg.Payment.ChannelID)
-	if err := h.cash.MoveCoins(db, src, pc.Recipient, diff); err != nil {
+	if err := cash.MoveAmount(h.cash, db, src, pc.Recipient, diff); err != nil {
 		return res, err
 	}
 
 	// Track total amount transferred from the payment channel to the
 	// recipients account.
-	pc.Transferred = msg.Payment.Amount
+	if err := pc.setTransferred(msg.Payment.PaidAmount()); err != nil {
+		return res, errors.InvalidMsgErr.New("invalid amount")
+	}
 
 	// We care about the latest memo only. Full history can be always
 	// rebuild from the blockchain.
@@ -206,7 +219,7 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 	//
 	// To avoid "empty" payment channels in our database, delete it without
 	// waiting for the explicit close request.
-	if pc.Transferred.Equals(*pc.Total) {
+	if pc.TransferredAmount().Equals(pc.TotalAmount()) {
 		err := h.bucket.Delete(db, msg.Payment.ChannelID)
 		return res, err
 	}
@@ -243,7 +256,7 @@ func (h *closePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore
 	}
 
 	// If payment channel funds were exhausted anyone is free to close it.
-	if pc.Total.Equals(*pc.Transferred) {
+	if pc.TotalAmount().Equals(pc.TransferredAmount()) {
 		err := h.bucket.Delete(db, msg.ChannelID)
 		return res, err
 	}
@@ -258,12 +271,12 @@ func (h *closePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore
 
 	// Before deleting the channel, return to sender all leftover funds
 	// that are still allocated on this payment channel account.
-	diff, err := pc.Total.Subtract(*pc.Transferred)
+	diff, err := pc.TotalAmount().Subtract(pc.TransferredAmount())
 	if err != nil {
 		return res, err
 	}
 	src := paymentChannelAccount(msg.ChannelID)
-	if err := h.cash.MoveCoins(db, src, pc.Src, diff); err != nil {
+	if err := cash.MoveAmount(h.cash, db, src, pc.Src, diff); err != nil {
 		return res, err
 	}
 	err = h.bucket.Delete(db, msg.ChannelID)
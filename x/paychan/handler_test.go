@@ -199,6 +199,74 @@ func TestPaymentChannelHandlers(t *testing.T) {
 				},
 			},
 		},
+		"precise amounts can be transferred": {
+			actions: []action{
+				{
+					conditions: []weave.Condition{src},
+					msg: &CreatePaymentChannelMsg{
+						Src:          src.Address(),
+						Recipient:    recipient.Address(),
+						SenderPubkey: srcSig.PublicKey(),
+						PreciseTotal: dogeAmount("10", 0),
+						Timeout:      1000,
+						Memo:         "start",
+					},
+					blocksize: 100,
+				},
+				{
+					conditions: []weave.Condition{src},
+					msg: setSignature(srcSig, &TransferPaymentChannelMsg{
+						Payment: &Payment{
+							ChainID:       "testchain-123",
+							ChannelID:     asSeqID(1),
+							PreciseAmount: dogeAmount("2500000000000000001", 18),
+							Memo:          "much precision",
+						},
+					}),
+					blocksize: 103,
+				},
+			},
+			dbtests: []querycheck{
+				{
+					path:   "/paychans",
+					data:   asSeqID(1),
+					bucket: payChanBucket.Bucket,
+					wantRes: []orm.Object{
+						orm.NewSimpleObj(asSeqID(1), &PaymentChannel{
+							Src:                src.Address(),
+							Recipient:          recipient.Address(),
+							SenderPubkey:       srcSig.PublicKey(),
+							PreciseTotal:       dogeAmount("10", 0),
+							Timeout:            1000,
+							Memo:               "much precision",
+							PreciseTransferred: dogeAmount("2500000000000000001", 18),
+						}),
+					},
+				},
+				// Balances that do not fit a coin are
+				// held as precise amounts.
+				{
+					path:   "/wallets",
+					data:   paymentChannelAccount(asSeqID(1)),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						orm.NewSimpleObj(paymentChannelAccount(asSeqID(1)), &cash.Set{
+							Amounts: []*x.Amount{dogeAmount("7499999999999999999", 18)},
+						}),
+					},
+				},
+				{
+					path:   "/wallets",
+					data:   recipient.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						orm.NewSimpleObj(recipient.Address(), &cash.Set{
+							Amounts: []*x.Amount{dogeAmount("2500000000000000001", 18)},
+						}),
+					},
+				},
+			},
+		},
 		"closing a channel with a transfer made releases funds": {
 			actions: []action{
 				{
@@ -521,6 +589,10 @@ func dogeCoin(w, f int64) *x.Coin {
 	return &c
 }
 
+func dogeAmount(value string, decimals uint32) *x.Amount {
+	return &x.Amount{Value: value, Decimals: decimals, Ticker: "DOGE"}
+}
+
 // action represents a single request call that is handled by a handler.
 type action struct {
 	conditions     []weave.Condition
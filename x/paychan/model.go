@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
 )
 
 var _ orm.CloneableData = (*PaymentChannel)(nil)
@@ -22,21 +23,75 @@ func (pc *PaymentChannel) Validate() error {
 	if pc.Timeout <= 0 {
 		return errors.InvalidModelErr.New("timeout in the past")
 	}
-	if pc.Total == nil || !pc.Total.IsPositive() {
+	if pc.Total != nil && pc.PreciseTotal != nil {
+		return errors.InvalidModelErr.New("both total and precise total")
+	}
+	total := pc.TotalAmount()
+	if !total.IsPositive() {
 		return errors.InvalidModelErr.New("negative total")
 	}
+	if err := total.Validate(); err != nil {
+		return errors.Wrap(err, "invalid total")
+	}
 	if len(pc.Memo) > 128 {
 		return errors.InvalidModelErr.New("memo too long")
 	}
 
+	// Transferred value must be of the same kind as the total.
+	if pc.PreciseTotal == nil && (pc.Transferred == nil || pc.PreciseTransferred != nil) {
+		return errors.InvalidModelErr.New("invalid transferred value")
+	}
+	if pc.PreciseTotal != nil && (pc.PreciseTransferred == nil || pc.Transferred != nil) {
+		return errors.InvalidModelErr.New("invalid transferred value")
+	}
 	// Transfer value must not be greater than the Total value represented
 	// by the PaymentChannel.
-	if pc.Transferred == nil || !pc.Transferred.IsNonNegative() || pc.Transferred.Compare(*pc.Total) > 0 {
+	transferred := pc.TransferredAmount()
+	if !transferred.IsNonNegative() || transferred.Compare(total) > 0 {
 		return errors.InvalidModelErr.New("invalid transferred value")
 	}
 	return nil
 }
 
+// TotalAmount returns the total value of the channel, whether
+// it is set as a coin or as a precise amount.
+func (pc *PaymentChannel) TotalAmount() x.Amount {
+	return amountOf(pc.Total, pc.PreciseTotal)
+}
+
+// TransferredAmount returns the transferred value of the channel, whether
+// it is set as a coin or as a precise amount.
+func (pc *PaymentChannel) TransferredAmount() x.Amount {
+	return amountOf(pc.Transferred, pc.PreciseTransferred)
+}
+
+// setTransferred updates the transferred value, using the same
+// representation as the total.
+func (pc *PaymentChannel) setTransferred(a x.Amount) error {
+	if pc.PreciseTotal != nil {
+		pc.PreciseTransferred = &a
+		return nil
+	}
+	c, err := a.Coin()
+	if err != nil {
+		return err
+	}
+	pc.Transferred = &c
+	return nil
+}
+
+// amountOf returns the precise amount if set, the coin otherwise.
+func amountOf(c *x.Coin, precise *x.Amount) x.Amount {
+	switch {
+	case precise != nil:
+		return *precise
+	case c != nil:
+		return c.Amount()
+	default:
+		return x.Amount{}
+	}
+}
+
 // Copy returns a shallow copy of this PaymentChannel.
 func (pc PaymentChannel) Copy() orm.CloneableData {
 	return &pc
@@ -3,6 +3,7 @@ package paychan
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x"
 )
 
 var _ weave.Msg = (*CreatePaymentChannelMsg)(nil)
@@ -25,7 +26,10 @@ func (m *CreatePaymentChannelMsg) Validate() error {
 	if m.Recipient == nil {
 		return errors.InvalidMsgErr.New("missing recipient")
 	}
-	if m.Total == nil || m.Total.IsZero() {
+	if m.Total != nil && m.PreciseTotal != nil {
+		return errors.InvalidMsgErr.New("both total and precise total")
+	}
+	if total := m.TotalAmount(); total.IsZero() || total.Validate() != nil {
 		return errors.InvalidMsgErr.New("inalid total amount")
 	}
 	if m.Timeout <= 0 {
@@ -42,6 +46,12 @@ func (CreatePaymentChannelMsg) Path() string {
 	return pathCreatePaymentChannelMsg
 }
 
+// TotalAmount returns the total value of the channel to create, whether
+// it is set as a coin or as a precise amount.
+func (m *CreatePaymentChannelMsg) TotalAmount() x.Amount {
+	return amountOf(m.Total, m.PreciseTotal)
+}
+
 func (m *TransferPaymentChannelMsg) Validate() error {
 	if m.Signature == nil {
 		return errors.InvalidMsgErr.New("missing signature")
@@ -55,12 +65,21 @@ func (m *TransferPaymentChannelMsg) Validate() error {
 	if m.Payment.ChannelID == nil {
 		return errors.InvalidMsgErr.New("missing channel ID")
 	}
-	if !m.Payment.Amount.IsPositive() {
+	if m.Payment.Amount != nil && m.Payment.PreciseAmount != nil {
+		return errors.InvalidMsgErr.New("both amount and precise amount")
+	}
+	if amount := m.Payment.PaidAmount(); !amount.IsPositive() || amount.Validate() != nil {
 		return errors.InvalidMsgErr.New("invalid amount value")
 	}
 	return nil
 }
 
+// PaidAmount returns the cumulative value of the payment, whether
+// it is set as a coin or as a precise amount.
+func (p *Payment) PaidAmount() x.Amount {
+	return amountOf(p.Amount, p.PreciseAmount)
+}
+
 func (TransferPaymentChannelMsg) Path() string {
 	return pathTransferPaymentChannelMsg
 }
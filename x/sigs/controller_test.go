@@ -108,6 +108,36 @@ func TestVerifySignature(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifySecp256k1Signature(t *testing.T) {
+	kv := store.MemStore()
+	priv := crypto.GenPrivKeySecp256k1()
+	perm := priv.PublicKey().Condition()
+
+	chainID := "emo-music-2345"
+	bz := []byte("my special valentine")
+	tx := NewStdTx(bz)
+
+	sig0, err := SignTx(priv, tx, chainID, 0)
+	require.NoError(t, err)
+	sig1, err := SignTx(priv, tx, chainID, 1)
+	require.NoError(t, err)
+
+	sign, err := VerifySignature(kv, sig0, bz, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, perm, sign)
+
+	// a signature of another key type does not match the stored key
+	other, err := SignTx(crypto.GenPrivKeyEd25519(), tx, chainID, 1)
+	require.NoError(t, err)
+	other.Pubkey = sig1.Pubkey
+	_, err = VerifySignature(kv, other, bz, chainID)
+	assert.True(t, IsInvalidSignatureErr(err))
+
+	sign, err = VerifySignature(kv, sig1, bz, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, perm, sign)
+}
+
 func TestVerifyTxSignatures(t *testing.T) {
 	kv := store.MemStore()
 
@@ -13,29 +13,29 @@ const (
 )
 
 var (
-	errInvalidSequence = fmt.Errorf("Invalid sequence number")
+	codes = errors.RegisterRange("sigs", 20, 29)
+
+	InvalidSequenceErr = codes.Register(CodeInvalidSequence, "invalid sequence")
 )
 
 func ErrInvalidSequence(why string, args ...interface{}) error {
 	if len(args) > 0 {
 		why = fmt.Sprintf(why, args...)
 	}
-	return errors.WithLog(why, errInvalidSequence, CodeInvalidSequence)
+	return InvalidSequenceErr.New(why)
 }
 func IsInvalidSequenceErr(err error) bool {
-	return errors.IsSameError(errInvalidSequence, err)
+	return errors.HasErrorCode(err, CodeInvalidSequence)
 }
 
 //------ various invalid signatures ----
 // all will match IsInvalidSignatureError
 
 func ErrMissingPubkey() error {
-	invalidSig := errors.ErrInvalidSignature()
-	return errors.WithLog("Missing public key", invalidSig, errors.CodeUnauthorized)
+	return errors.Wrap(errors.ErrInvalidSignature(), "missing public key")
 }
 func ErrPubkeyAddressMismatch() error {
-	invalidSig := errors.ErrInvalidSignature()
-	return errors.WithLog("Pubkey and Address don't match", invalidSig, errors.CodeUnauthorized)
+	return errors.Wrap(errors.ErrInvalidSignature(), "pubkey and address don't match")
 }
 
 var IsInvalidSignatureErr = errors.IsInvalidSignatureErr
@@ -37,7 +37,9 @@ func (KeyTagger) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx,
 }
 
 // Deliver passes in a recording KVStore into the child and
-// uses that to calculate tags to add to DeliverResult
+// uses that to calculate tags to add to DeliverResult.
+//
+// The full state diff of the transaction is written to the debug log.
 func (KeyTagger) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx,
 	next weave.Deliverer) (weave.DeliverResult, error) {
 
@@ -47,10 +49,35 @@ func (KeyTagger) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx,
 		return res, err
 	}
 
+	logChangeset(ctx, record)
 	res.Tags = append(res.Tags, kvPairs(record)...)
 	return res, nil
 }
 
+// logChangeset writes all changes recorded in db to the debug log
+func logChangeset(ctx weave.Context, db weave.KVStore) {
+	r, ok := db.(store.Recorder)
+	if !ok {
+		return
+	}
+	changes := r.Changeset()
+	if len(changes) == 0 {
+		return
+	}
+	// both values are Stringers, so they are only rendered when
+	// the logger does not filter out debug messages
+	weave.GetLogger(ctx).Debug("state diff",
+		"buckets", changedBuckets(changes),
+		"changes", changes)
+}
+
+// changedBuckets renders the names of all buckets in a changeset
+type changedBuckets store.Changeset
+
+func (c changedBuckets) String() string {
+	return strings.Join(store.Changeset(c).Buckets(), ",")
+}
+
 // kvPairs will get the kvpairs from an underlying store if possible
 // use this, so we can use interface for recordingStore
 func kvPairs(db weave.KVStore) common.KVPairs {
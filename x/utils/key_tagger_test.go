@@ -1,12 +1,14 @@
 package utils
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/store"
@@ -131,3 +133,18 @@ func TestKeyTagger(t *testing.T) {
 		})
 	}
 }
+
+func TestLogChangeset(t *testing.T) {
+	db := store.NewRecordingStore(store.MemStore())
+	db.Set([]byte("foo:demo"), []byte("data"))
+
+	var buf bytes.Buffer
+	ctx := weave.WithLogger(context.Background(),
+		log.NewFilter(log.NewTMLogger(&buf), log.AllowInfo()))
+	logChangeset(ctx, db)
+	assert.Empty(t, buf.String())
+
+	ctx = weave.WithLogger(context.Background(), log.NewTMLogger(&buf))
+	logChangeset(ctx, db)
+	assert.Contains(t, buf.String(), "buckets=foo")
+}
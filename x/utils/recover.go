@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -20,7 +22,7 @@ func NewRecovery() Recovery {
 func (r Recovery) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	next weave.Checker) (res weave.CheckResult, err error) {
 
-	defer errors.Recover(&err)
+	defer recoverAndLog(ctx, &err)
 	return next.Check(ctx, store, tx)
 }
 
@@ -28,6 +30,17 @@ func (r Recovery) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 func (r Recovery) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	next weave.Deliverer) (res weave.DeliverResult, err error) {
 
-	defer errors.Recover(&err)
+	defer recoverAndLog(ctx, &err)
 	return next.Deliver(ctx, store, tx)
 }
+
+// recoverAndLog sets err upon panic, as errors.Recover does, and
+// logs the stack of the panic. The error itself is redacted
+// before it is returned over ABCI.
+func recoverAndLog(ctx weave.Context, err *error) {
+	if r := recover(); r != nil {
+		*err = errors.NormalizePanic(r)
+		weave.GetLogger(ctx).Error("recovered from panic",
+			"err", fmt.Sprintf("%+v", *err))
+	}
+}
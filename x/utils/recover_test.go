@@ -1,12 +1,16 @@
 package utils
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/x"
 )
@@ -32,3 +36,21 @@ func TestRecovery(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "panic: boom", err.Error())
 }
+
+func TestRecoveryLogsStack(t *testing.T) {
+	var help x.TestHelpers
+	pan := help.PanicHandler(fmt.Errorf("boom"))
+
+	var logs bytes.Buffer
+	ctx := weave.WithLogger(context.Background(), log.NewTMLogger(&logs))
+
+	_, err := NewRecovery().Deliver(ctx, store.MemStore(), nil, pan)
+	assert.Error(t, err)
+
+	// the stack starts where the handler panicked
+	assert.Contains(t, logs.String(), "recovered from panic")
+	assert.Contains(t, logs.String(), "panicHandler.Deliver")
+
+	// but it is never returned to the client
+	assert.Equal(t, errors.InternalErr, errors.Redact(err))
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// StrictCheck is a decorator that passes a read-only store to
+// its children on CheckTx, to verify that they do not rely on
+// writes they shouldn't make. Deliver is passed through untouched.
+//
+// Any write attempted during Check is returned as an error,
+// rather than crashing the whole call chain.
+type StrictCheck struct{}
+
+var _ weave.Decorator = StrictCheck{}
+
+// NewStrictCheck creates a StrictCheck decorator
+func NewStrictCheck() StrictCheck {
+	return StrictCheck{}
+}
+
+// Check wraps the store in a read-only guard
+func (StrictCheck) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx,
+	next weave.Checker) (res weave.CheckResult, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			rerr, ok := r.(error)
+			if !ok || !store.IsReadOnlyErr(rerr) {
+				panic(r)
+			}
+			err = errors.Wrap(rerr, "strict check")
+		}
+	}()
+	return next.Check(ctx, store.ReadOnly(db), tx)
+}
+
+// Deliver does nothing
+func (StrictCheck) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx,
+	next weave.Deliverer) (weave.DeliverResult, error) {
+	return next.Deliver(ctx, db, tx)
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x"
+)
+
+func TestStrictCheck(t *testing.T) {
+	var help x.TestHelpers
+
+	k, v := []byte("key"), []byte("value")
+	strict := NewStrictCheck()
+
+	ctx := context.Background()
+	kv := store.MemStore()
+
+	// writing on check is reported as error, nothing is written
+	_, err := strict.Check(ctx, kv, nil, help.WriteHandler(k, v, nil))
+	assert.Error(t, err)
+	assert.True(t, store.IsReadOnlyErr(err))
+	assert.False(t, kv.Has(k))
+
+	// reading handlers are fine
+	counter := help.CountingHandler()
+	_, err = strict.Check(ctx, kv, nil, counter)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, counter.GetCount())
+
+	// other panics are not swallowed
+	pan := help.PanicHandler(fmt.Errorf("boom"))
+	assert.Panics(t, func() { strict.Check(ctx, kv, nil, pan) })
+
+	// deliver may still write
+	_, err = strict.Deliver(ctx, kv, nil, help.WriteHandler(k, v, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, v, kv.Get(k))
+}
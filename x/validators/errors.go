@@ -1,7 +1,6 @@
 package validators
 
 import (
-	stderr "errors"
 	"reflect"
 
 	"github.com/iov-one/weave/errors"
@@ -19,16 +18,18 @@ const (
 )
 
 var (
-	errEmptyDiff         = stderr.New("Empty validator diff")
-	errWrongType         = stderr.New("Wrong type for accounts storage")
-	errInvalidPubKey     = stderr.New("Invalid public key")
-	errEmptyValidatorSet = stderr.New("Empty validator set")
-	errInvalidPower      = stderr.New("Power value is invalid")
-	errNotFound          = stderr.New("Not found")
+	codes = errors.RegisterRange("validators", 40, 49)
+
+	EmptyDiffErr         = codes.Register(CodeEmptyDiff, "empty diff")
+	WrongTypeErr         = codes.Register(CodeWrongType, "wrong type")
+	InvalidPubKeyErr     = codes.Register(CodeInvalidPubKey, "invalid public key")
+	EmptyValidatorSetErr = codes.Register(CodeEmptyValidatorSet, "empty validator set")
+	InvalidPowerErr      = codes.Register(CodeInvalidPower, "invalid power")
+	NotFoundErr          = codes.Register(CodeNotFound, "not found")
 )
 
 func ErrEmptyDiff() error {
-	return errors.WithCode(errEmptyDiff, CodeEmptyDiff)
+	return EmptyDiffErr
 }
 
 func ErrWrongType(t interface{}) error {
@@ -36,9 +37,21 @@ func ErrWrongType(t interface{}) error {
 	if t != nil {
 		typeName = reflect.TypeOf(t).Name()
 	}
-	return errors.WithLog(typeName, errWrongType, CodeWrongType)
+	return WrongTypeErr.New(typeName)
+}
+
+func ErrInvalidPubKey() error {
+	return InvalidPubKeyErr
+}
+
+func ErrInvalidPower() error {
+	return InvalidPowerErr
+}
+
+func ErrEmptyValidatorSet() error {
+	return EmptyValidatorSetErr
 }
 
 func ErrNotFound(entityName string) error {
-	return errors.WithLog(entityName, errNotFound, CodeNotFound)
+	return NotFoundErr.New(entityName)
 }
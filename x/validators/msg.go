@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/iov-one/weave"
-	"github.com/iov-one/weave/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -21,10 +20,10 @@ func (*SetValidatorsMsg) Path() string {
 func (m ValidatorUpdate) Validate() error {
 	if len(m.Pubkey.Data) != 32 ||
 		strings.ToLower(m.Pubkey.Type) != "ed25519" {
-		return errors.WithCode(errInvalidPubKey, CodeInvalidPubKey)
+		return ErrInvalidPubKey()
 	}
 	if m.Power < 0 {
-		return errors.WithCode(errInvalidPower, CodeInvalidPower)
+		return ErrInvalidPower()
 	}
 	return nil
 }
@@ -45,7 +44,7 @@ func (m Pubkey) AsABCI() abci.PubKey {
 
 func (m *SetValidatorsMsg) Validate() error {
 	if len(m.ValidatorUpdates) == 0 {
-		return errors.WithCode(errEmptyValidatorSet, CodeEmptyValidatorSet)
+		return ErrEmptyValidatorSet()
 	}
 	for _, v := range m.ValidatorUpdates {
 		if err := v.Validate(); err != nil {